@@ -15,9 +15,11 @@
 package bigo
 
 import (
+	"cmp"
 	"fmt"
 	"math"
 	"math/big"
+	"slices"
 	"sort"
 
 	"github.com/rsned/bigmath"
@@ -42,6 +44,12 @@ type BigO struct {
 	// floatCutoffMin is the smallest float64/int input value that will allow
 	// this function to return a valid float64 value. e.g., Log functions
 	// on values <1 will return NaN, 0 will return -Inf, etc.
+	//
+	// Any N below the cutoff is predicted as 0 when rating, predicting, or
+	// fitting. The cutoff should therefore be the point where the model is 0
+	// (1 for log n, e for log log n) so that the clamped model stays
+	// continuous and non-decreasing. Small N such as 1 and 2 then flatten the
+	// start of the curve instead of adding a jump that skews the correlation.
 	floatCutoffMin float64
 
 	// floatCutoffMax is the largest float64/int input value that will allow
@@ -152,8 +160,13 @@ func filterPositiveDataBig(ns []int, vals []*big.Float) ([]int, []*big.Float, in
 //
 // Non-positive input sizes are filtered out before analysis.
 func (o *BigO) Rate(ns []int, vals []float64) (*Rating, error) {
+	return o.rateWith(ns, vals, correlation.Pearson)
+}
+
+// rateWith is Rate using the given correlation method.
+func (o *BigO) rateWith(ns []int, vals []float64, method correlation.Type) (*Rating, error) {
 	if len(ns) != len(vals) {
-		return defaultRating, fmt.Errorf("the N's and values must be the same length")
+		return defaultRating, fmt.Errorf("%w: the N's and values must be the same length", ErrLengthMismatch)
 	}
 
 	// Filter out non-positive input sizes
@@ -165,9 +178,14 @@ func (o *BigO) Rate(ns []int, vals []float64) (*Rating, error) {
 	}
 
 	if len(ns) < 3 {
-		return defaultRating, fmt.Errorf("there must be at least 3 (but preferably more) data points")
+		return defaultRating, fmt.Errorf("%w: there must be at least 3 (but preferably more) data points", ErrInsufficientData)
 	}
 
+	// Scale the values down by the value at the smallest N, the same as
+	// RateBig does, so that the intermediate sums in the correlation and
+	// variance don't overflow for enormous values.
+	vals = scaleByStartValue(ns, vals)
+
 	// Special handling for O(1) constant time detection
 	if o == Constant {
 		return o.detectConstantTime(vals)
@@ -217,32 +235,242 @@ func (o *BigO) Rate(ns []int, vals []float64) (*Rating, error) {
 	var err error
 
 	if !needsBig {
-		corr, err = correlation.Correlate(predicteds, vals, correlation.Pearson)
+		corr, err = correlate(predicteds, vals, method)
 		if err != nil {
 			return defaultRating, err
 		}
 	} else {
-		corr, err = correlation.CorrelateBig(predictedBigs, valsBig, correlation.Pearson)
+		corr, err = correlateBig(predictedBigs, valsBig, method)
 		if err != nil {
 			return defaultRating, err
 		}
 	}
 
 	rating := &Rating{
-		bigO:  o,
-		score: corr,
+		bigO:         o,
+		score:        corr,
+		nonMonotonic: false,
+	}
+
+	return rating, nil
+}
+
+// RateWeighted generates the ranking for this BigO like Rate, but weights
+// each data point's contribution to the correlation by the matching entry in
+// weights, so that noisier points can be downweighted. Weights must be
+// positive. If every weight is the same, the result is identical to Rate.
+//
+// For O(1) the weights apply to the coefficient of variation instead. The
+// weights only apply where the correlation can be computed in float64; if any
+// N needs big.Float math for this BigO, they are ignored and the result is
+// the same as Rate.
+func (o *BigO) RateWeighted(ns []int, vals, weights []float64) (*Rating, error) {
+	return o.rateWeightedWith(ns, vals, weights, correlation.Pearson)
+}
+
+// rateWeightedWith is RateWeighted using the given correlation method. For
+// Spearman, the weighted Pearson correlation is taken of the ranks.
+func (o *BigO) rateWeightedWith(ns []int, vals, weights []float64, method correlation.Type) (*Rating, error) {
+	if len(ns) != len(vals) || len(ns) != len(weights) {
+		return defaultRating, fmt.Errorf("%w: the N's, values, and weights must be the same length", ErrLengthMismatch)
+	}
+
+	for i, w := range weights {
+		if !(w > 0) || math.IsInf(w, 0) {
+			return defaultRating, fmt.Errorf("weight %v at N=%d must be a positive finite number", w, ns[i])
+		}
+	}
+
+	uniform := !slices.ContainsFunc(weights, func(w float64) bool { return w != weights[0] })
+	if uniform {
+		return o.rateWith(ns, vals, method)
+	}
+
+	// Filter out non-positive input sizes, keeping the weights aligned.
+	var filteredNs []int
+	var filteredVals, filteredWeights []float64
+	for i, n := range ns {
+		if n > 0 {
+			filteredNs = append(filteredNs, n)
+			filteredVals = append(filteredVals, vals[i])
+			filteredWeights = append(filteredWeights, weights[i])
+		}
+	}
+
+	if len(filteredNs) < 3 {
+		return defaultRating, fmt.Errorf("%w: there must be at least 3 (but preferably more) data points", ErrInsufficientData)
+	}
+
+	if o == Constant {
+		rating := &Rating{
+			bigO:         o,
+			score:        cvToScore(weightedCoefficientOfVariation(filteredVals, filteredWeights)),
+			nonMonotonic: false,
+		}
+
+		return rating, nil
+	}
+
+	predicteds := make([]float64, len(filteredNs))
+	for i, n := range filteredNs {
+		predicteds[i] = o.predictFloat(n)
+		if math.IsInf(predicteds[i], 0) {
+			return o.rateWith(ns, vals, method)
+		}
+	}
+
+	scaledVals := scaleByStartValue(filteredNs, filteredVals)
+	if method == correlation.Spearman {
+		predicteds, scaledVals = ranks(predicteds), ranks(scaledVals)
+	}
+
+	corr, err := weightedPearson(predicteds, scaledVals, filteredWeights)
+	if err != nil {
+		return defaultRating, err
+	}
+
+	rating := &Rating{
+		bigO:         o,
+		score:        corr,
+		nonMonotonic: false,
 	}
 
 	return rating, nil
 }
 
+// correlate returns the correlation of x and y using the given method.
+// Spearman is computed here as the Pearson correlation of the ranks, and any
+// other method is passed through to the correlation package.
+func correlate(x, y []float64, method correlation.Type) (float64, error) {
+	if method == correlation.Spearman {
+		return correlation.Correlate(ranks(x), ranks(y), correlation.Pearson)
+	}
+
+	return correlation.Correlate(x, y, method)
+}
+
+// correlateBig is correlate for big.Float values.
+func correlateBig(x, y []*big.Float, method correlation.Type) (float64, error) {
+	if method == correlation.Spearman {
+		return correlation.Correlate(ranksBig(x), ranksBig(y), correlation.Pearson)
+	}
+
+	return correlation.CorrelateBig(x, y, method)
+}
+
+// ranks returns the 1-based rank of each value in vals. Tied values all get
+// the average of the ranks they span.
+func ranks(vals []float64) []float64 {
+	return rankBy(len(vals), func(i, j int) int {
+		return cmp.Compare(vals[i], vals[j])
+	})
+}
+
+// ranksBig is ranks for big.Float values.
+func ranksBig(vals []*big.Float) []float64 {
+	return rankBy(len(vals), func(i, j int) int {
+		return vals[i].Cmp(vals[j])
+	})
+}
+
+// rankBy returns the 1-based rank of each of n values ordered by compare,
+// which compares the values at two indexes. Ties get their average rank.
+func rankBy(n int, compare func(i, j int) int) []float64 {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+
+	slices.SortStableFunc(order, compare)
+
+	result := make([]float64, n)
+	for start := 0; start < n; {
+		end := start + 1
+		for end < n && compare(order[start], order[end]) == 0 {
+			end++
+		}
+
+		// Ranks start+1 through end average to this.
+		rank := float64(start+end+1) / 2
+		for _, idx := range order[start:end] {
+			result[idx] = rank
+		}
+
+		start = end
+	}
+
+	return result
+}
+
+// weightedPearson returns the Pearson correlation coefficient of x and y
+// where each pair contributes to the means, covariance, and variances in
+// proportion to its weight.
+func weightedPearson(x, y, w []float64) (float64, error) {
+	sumW, meanX, meanY := 0.0, 0.0, 0.0
+	for i := range x {
+		sumW += w[i]
+		meanX += w[i] * x[i]
+		meanY += w[i] * y[i]
+	}
+
+	meanX /= sumW
+	meanY /= sumW
+
+	covXY, varX, varY := 0.0, 0.0, 0.0
+	for i := range x {
+		dx, dy := x[i]-meanX, y[i]-meanY
+		covXY += w[i] * dx * dy
+		varX += w[i] * dx * dx
+		varY += w[i] * dy * dy
+	}
+
+	if varX == 0 || varY == 0 {
+		return 0, fmt.Errorf("cannot compute a correlation when either set of values is constant")
+	}
+
+	return covXY / math.Sqrt(varX*varY), nil
+}
+
+// scaleByStartValue returns a copy of vals divided by the magnitude of the
+// value at the smallest N. Dividing by a positive constant leaves both the
+// correlation and the coefficient of variation unchanged, but keeps sums of
+// squares of huge values within the float64 range. If that value is 0 or not
+// finite, vals is returned unchanged.
+func scaleByStartValue(ns []int, vals []float64) []float64 {
+	startN := math.MaxInt
+	startVal := 0.0
+	for i, n := range ns {
+		if n < startN {
+			startN = n
+			startVal = vals[i]
+		}
+	}
+
+	scale := math.Abs(startVal)
+	if scale == 0 || math.IsInf(scale, 0) || math.IsNaN(scale) {
+		return vals
+	}
+
+	scaled := make([]float64, len(vals))
+	for i, v := range vals {
+		scaled[i] = v / scale
+	}
+
+	return scaled
+}
+
 // RateBig is a helper function that rates the data using big.Float values to
 // perform the correlation and scoring.
 //
 // Non-positive input sizes are filtered out before analysis.
 func (o *BigO) RateBig(ns []int, vals []*big.Float) (*Rating, error) {
+	return o.rateBigWith(ns, vals, correlation.Pearson)
+}
+
+// rateBigWith is RateBig using the given correlation method.
+func (o *BigO) rateBigWith(ns []int, vals []*big.Float, method correlation.Type) (*Rating, error) {
 	if len(ns) != len(vals) {
-		return defaultRating, fmt.Errorf("the N's and values must be the same length")
+		return defaultRating, fmt.Errorf("%w: the N's and values must be the same length", ErrLengthMismatch)
 	}
 
 	// Filter out non-positive input sizes
@@ -254,7 +482,7 @@ func (o *BigO) RateBig(ns []int, vals []*big.Float) (*Rating, error) {
 	}
 
 	if len(ns) < 3 {
-		return defaultRating, fmt.Errorf("there must be at least 3 (but preferably more) data points")
+		return defaultRating, fmt.Errorf("%w: there must be at least 3 (but preferably more) data points", ErrInsufficientData)
 	}
 
 	// Special handling for O(1) constant time detection
@@ -289,9 +517,11 @@ func (o *BigO) RateBig(ns []int, vals []*big.Float) (*Rating, error) {
 		// If the scaled N is below the limit that can be handled by this.
 		// then set the predicted value to 0. (e.g., log(x) for x < 1 goes to
 		// -Infinity)
+		// Past floatCutoffMax the float64 model overflows, but the big.Float
+		// one does not, so every N keeps a prediction to pair with its value.
 		if scaledN < o.floatCutoffMin {
 			predicteds = append(predicteds, big.NewFloat(0))
-		} else if scaledN <= o.floatCutoffMax {
+		} else {
 			predicteds = append(predicteds, o.funcFloatBig(scaledN))
 		}
 
@@ -301,27 +531,179 @@ func (o *BigO) RateBig(ns []int, vals []*big.Float) (*Rating, error) {
 	var corr float64
 	var err error
 
-	corr, err = correlation.CorrelateBig(predicteds, scaledVals, correlation.Pearson)
+	corr, err = correlateBig(predicteds, scaledVals, method)
 	if err != nil {
 		return defaultRating, err
 	}
 
 	rating := &Rating{
-		bigO:  o,
-		score: corr,
+		bigO:         o,
+		score:        corr,
+		nonMonotonic: false,
 	}
 
 	return rating, nil
 }
 
+// Predict returns the value this BigO's model generates for each N, using the
+// same cutoff handling as Rate: 0 for N below the model's valid input range
+// and +Inf for N whose value exceeds the float64 range. These are raw model
+// values such as n² for Quadratic, e.g., for plotting the shape of a fit; see
+// PredictScaled for values in the same units as a set of measurements.
+func (o *BigO) Predict(ns []int) []float64 {
+	predicteds := make([]float64, len(ns))
+	for i, n := range ns {
+		predicteds[i] = o.predictFloat(n)
+	}
+
+	return predicteds
+}
+
+// PredictScaled returns the value this BigO's model generates for each N
+// scaled into the same units as vals, the measurements at those N, so the
+// fitted curve can be plotted directly over the data. The scale is the single
+// multiplicative constant c minimizing Σ (vals - c·f(n))², a least squares
+// fit through the origin.
+//
+// Only the N whose model value fits in a float64 are used to fit c. For the
+// others, c·f(n) is computed with big.Float and converted back to float64,
+// which is +Inf if the scaled value is still too large. N below the model's
+// valid input range predict 0. If the model is 0 at every N, so there is
+// nothing to scale, every value is 0. Returns nil if ns and vals differ in
+// length.
+func (o *BigO) PredictScaled(ns []int, vals []float64) []float64 {
+	if len(ns) != len(vals) {
+		return nil
+	}
+
+	c, _ := o.scaleCoefficient(ns, vals)
+
+	scaled := make([]float64, len(ns))
+	for i, n := range ns {
+		f := o.predictFloat(n)
+		if !math.IsInf(f, 0) {
+			scaled[i] = c * f
+
+			continue
+		}
+
+		scaled[i], _ = new(big.Float).Mul(o.funcFloatBig(float64(n)), big.NewFloat(c)).Float64()
+	}
+
+	return scaled
+}
+
+// scaleCoefficient returns the least squares constant c through the origin
+// for vals ≈ c·f(n), where f is this BigO's model, using only the N whose
+// model value is finite. If the model is 0 at all of those N, ok is false.
+func (o *BigO) scaleCoefficient(ns []int, vals []float64) (float64, bool) {
+	sumFV, sumFF := 0.0, 0.0
+	for i, n := range ns {
+		f := o.predictFloat(n)
+		if math.IsInf(f, 0) {
+			continue
+		}
+
+		sumFV += f * vals[i]
+		sumFF += f * f
+	}
+
+	if sumFF == 0 {
+		return 0, false
+	}
+
+	return sumFV / sumFF, true
+}
+
+// predictFloat returns the float64 value this BigO's model generates for the
+// given N, using the same cutoff handling as Rate. Values below the minimum
+// cutoff are 0 and values above the maximum cutoff are +Inf.
+func (o *BigO) predictFloat(n int) float64 {
+	switch {
+	case float64(n) < o.floatCutoffMin:
+		return 0
+	case float64(n) <= o.floatCutoffMax:
+		return o.funcFloatFloat(float64(n))
+	default:
+		return math.Inf(1)
+	}
+}
+
+// fitCoefficient returns the least squares intercept and leading coefficient
+// for vals ≈ intercept + coefficient*f(n), where f is this BigO's model. If
+// the model doesn't vary over the given N (e.g., Constant), the intercept is 0
+// and the coefficient is the mean of vals.
+func (o *BigO) fitCoefficient(ns []int, vals []float64) (float64, float64) {
+	xs := o.Predict(ns)
+
+	intercept, coefficient, ok := leastSquares(xs, vals)
+	if !ok {
+		meanX, meanY := mean(xs), mean(vals)
+		if meanX == 0 {
+			return 0, meanY
+		}
+
+		return 0, meanY / meanX
+	}
+
+	return intercept, coefficient
+}
+
+// leastSquares fits the line ys ≈ intercept + slope*xs by ordinary least
+// squares. If the xs don't vary, no line can be fit and ok is false.
+func leastSquares(xs, ys []float64) (float64, float64, bool) {
+	meanX, meanY := mean(xs), mean(ys)
+
+	covXY, varX := 0.0, 0.0
+	for i := range xs {
+		dx := xs[i] - meanX
+		covXY += dx * (ys[i] - meanY)
+		varX += dx * dx
+	}
+
+	if varX == 0 {
+		return meanY, 0, false
+	}
+
+	slope := covXY / varX
+
+	return meanY - slope*meanX, slope, true
+}
+
+// mean returns the arithmetic mean of the values.
+func mean(vals []float64) float64 {
+	sum := 0.0
+	for _, v := range vals {
+		sum += v
+	}
+
+	return sum / float64(len(vals))
+}
+
 // detectConstantTime implements special detection logic for O(1) complexity.
 // Since constant time algorithms should have minimal variance in timing,
 // we use coefficient of variation (CV = stddev/mean) instead of correlation.
 func (o *BigO) detectConstantTime(vals []float64) (*Rating, error) {
 	if len(vals) < 3 {
-		return defaultRating, fmt.Errorf("not enough data points for constant time detection")
+		return defaultRating, fmt.Errorf("%w: not enough data points for constant time detection", ErrInsufficientData)
+	}
+
+	// Lower CV indicates more constant behavior
+	cv := coefficientOfVariation(vals)
+	score := cvToScore(cv)
+	rating := &Rating{
+		bigO:         o,
+		score:        score,
+		nonMonotonic: false,
 	}
 
+	return rating, nil
+}
+
+// coefficientOfVariation returns the population standard deviation of the
+// values divided by their mean. If the mean is zero, the result is 0 when all
+// values are zero and +Inf otherwise.
+func coefficientOfVariation(vals []float64) float64 {
 	// Calculate mean
 	mean := 0.0
 	for _, v := range vals {
@@ -340,28 +722,49 @@ func (o *BigO) detectConstantTime(vals []float64) (*Rating, error) {
 	variance /= float64(len(vals))
 	stddev := math.Sqrt(variance)
 
-	// Calculate coefficient of variation (CV = stddev/mean)
-	// Lower CV indicates more constant behavior
 	// Handle edge case where mean is zero
-	var cv float64
 	if mean == 0 {
 		// If mean is zero and all values are zero, this is perfectly constant
 		if stddev == 0 {
-			cv = 0
-		} else {
-			// If mean is zero but there's variance, this is not constant
-			cv = math.Inf(1)
+			return 0
 		}
-	} else {
-		cv = stddev / mean
+
+		// If mean is zero but there's variance, this is not constant
+		return math.Inf(1)
 	}
-	score := cvToScore(cv)
-	rating := &Rating{
-		bigO:  o,
-		score: score,
+
+	return stddev / mean
+}
+
+// weightedCoefficientOfVariation is coefficientOfVariation with each value
+// contributing to the mean and variance in proportion to its weight.
+func weightedCoefficientOfVariation(vals, weights []float64) float64 {
+	sumW, mean := 0.0, 0.0
+	for i, v := range vals {
+		sumW += weights[i]
+		mean += weights[i] * v
 	}
 
-	return rating, nil
+	mean /= sumW
+
+	variance := 0.0
+	for i, v := range vals {
+		diff := v - mean
+		variance += weights[i] * diff * diff
+	}
+
+	variance /= sumW
+	stddev := math.Sqrt(variance)
+
+	if mean == 0 {
+		if stddev == 0 {
+			return 0
+		}
+
+		return math.Inf(1)
+	}
+
+	return stddev / mean
 }
 
 // cvToScore converts a coefficient of variation to a score.
@@ -398,7 +801,7 @@ func cvToScore(cv float64) float64 {
 // we use coefficient of variation (CV = stddev/mean) instead of correlation.
 func (o *BigO) detectConstantTimeBig(vals []*big.Float) (*Rating, error) {
 	if len(vals) < 3 {
-		return defaultRating, fmt.Errorf("not enough data points for constant time detection")
+		return defaultRating, fmt.Errorf("%w: not enough data points for constant time detection", ErrInsufficientData)
 	}
 
 	// Calculate mean
@@ -444,8 +847,9 @@ func (o *BigO) detectConstantTimeBig(vals []*big.Float) (*Rating, error) {
 	}
 	score := cvToScore(cvFloat)
 	rating := &Rating{
-		bigO:  o,
-		score: score,
+		bigO:         o,
+		score:        score,
+		nonMonotonic: false,
 	}
 
 	return rating, nil
@@ -491,7 +895,9 @@ var (
 		label:       "O(1)",
 		description: "An algorithm with O(1) complexity runs in constant time, regardless of the input size.",
 
-		floatCutoffMin: math.SmallestNonzeroFloat64,
+		// The model is 1 for every N, so no N is below the cutoff. Rate
+		// never uses it, but Predict and the fits do.
+		floatCutoffMin: 0,
 		floatCutoffMax: math.MaxFloat64,
 
 		scalingCutoff: math.MaxInt64,
@@ -612,6 +1018,45 @@ var (
 		},
 	}
 
+	// NLogLogN is a BigO instance for the n log log n function. It is near
+	// linear and shows up in the Sieve of Eratosthenes and some integer
+	// sorting algorithms.
+	NLogLogN = &BigO{
+		active:      true,
+		rank:        48,
+		label:       "O(n log log n)",
+		description: "An algorithm with O(n log log n) complexity has a runtime that grows with n times the double logarithm of the input size.",
+
+		scalingCutoff: math.MaxInt64,
+
+		// log(log(x)) is only positive for x > e, below that the values
+		// go to 0, negative, and then NaN.
+		floatCutoffMin: math.Exp(math.Exp(0)),
+		floatCutoffMax: math.MaxFloat64 / math.Log(math.Log(math.MaxFloat64)),
+
+		funcFloatFloat: func(x float64) float64 {
+			if x <= math.E {
+				return 0
+			}
+
+			return x * math.Log(math.Log(x))
+		},
+		funcFloatBig: func(x float64) *big.Float {
+			if x <= math.E {
+				return big.NewFloat(0)
+			}
+
+			return new(big.Float).Mul(big.NewFloat(x), bigmath.Log(bigmath.Log(big.NewFloat(x))))
+		},
+		funcBigBig: func(x *big.Float) *big.Float {
+			if x.Cmp(big.NewFloat(math.E)) <= 0 {
+				return big.NewFloat(0)
+			}
+
+			return new(big.Float).Mul(x, bigmath.Log(bigmath.Log(x)))
+		},
+	}
+
 	// NLogStarN is a BigO instance for the n log star n function.
 	NLogStarN = &BigO{
 		active:         true,
@@ -649,7 +1094,7 @@ var (
 		scalingCutoff: math.MaxInt64,
 
 		floatCutoffMin: 1,
-		floatCutoffMax: math.Log(math.MaxFloat64),
+		floatCutoffMax: math.MaxFloat64 / math.Log(math.MaxFloat64),
 
 		funcFloatFloat: func(x float64) float64 {
 			return x * math.Log(x)
@@ -814,6 +1259,7 @@ var (
 		Log,
 		Polylogarithmic,
 		Linear,
+		NLogLogN,
 		NLogStarN,
 		Linearithmic,
 		Quadratic,
@@ -839,3 +1285,175 @@ func init() {
 		return BigOOrdered[i].rank < BigOOrdered[j].rank
 	})
 }
+
+// newBigO returns an active BigO for a custom complexity class described by
+// the given float64 function. The big.Float variants are derived from f by
+// converting through float64, so values beyond the float64 range saturate.
+// It does no validation; code outside this package uses BigOBuilder, whose
+// Build checks the settings before calling this.
+func newBigO(rank int, label, description string, f func(x float64) float64) *BigO {
+	return &BigO{
+		active:      true,
+		rank:        rank,
+		label:       label,
+		description: description,
+
+		scalingCutoff: math.MaxInt64,
+
+		floatCutoffMin: 1,
+		floatCutoffMax: math.MaxFloat64,
+
+		funcFloatFloat: f,
+		funcFloatBig: func(x float64) *big.Float {
+			return big.NewFloat(f(x))
+		},
+		funcBigBig: func(x *big.Float) *big.Float {
+			v, _ := x.Float64()
+
+			return big.NewFloat(f(v))
+		},
+	}
+}
+
+// validateRegistration checks that o can be added to the given set of
+// registered BigOs: it must be non-nil with non-nil model functions, not
+// already be in the set, and not share a rank with any BigO in the set.
+func validateRegistration(o *BigO, registered []*BigO) error {
+	if o == nil {
+		return fmt.Errorf("cannot register a nil BigO")
+	}
+
+	if o.funcFloatFloat == nil || o.funcFloatBig == nil || o.funcBigBig == nil {
+		return fmt.Errorf("BigO %q must have non-nil model functions", o.label)
+	}
+
+	for _, b := range registered {
+		if b == o {
+			return fmt.Errorf("BigO %q is already registered", o.label)
+		}
+
+		if b.rank == o.rank {
+			return fmt.Errorf("BigO %q rank %d collides with %q", o.label, o.rank, b.label)
+		}
+	}
+
+	return nil
+}
+
+// NewPolynomialBigO returns an active BigO for O(n^degree), for data that
+// grows polynomially with a degree other than the 4 used by Polynomial, such
+// as O(n^5) or O(n^3.5). It can be substituted for Polynomial in a Classifier
+// with SetPolynomialBigO.
+//
+// The rank follows polynomialRank, so the new class orders among the
+// existing ones by how fast it grows. An error is returned if the degree is
+// not a finite positive number, or if the rank collides with a class other
+// than Polynomial, such as degree 1 with Linear or degree 2 with Quadratic.
+func NewPolynomialBigO(degree float64) (*BigO, error) {
+	if !(degree > 0) || math.IsInf(degree, 1) {
+		return nil, fmt.Errorf("polynomial degree %g must be a finite positive number", degree)
+	}
+
+	rank := polynomialRank(degree)
+
+	// n^degree overflows float64 once n exceeds MaxFloat64^(1/degree). For
+	// degrees of 1 or less it never grows faster than n itself.
+	floatCutoffMax := math.MaxFloat64
+	if degree > 1 {
+		floatCutoffMax = math.Pow(math.MaxFloat64, 1/degree)
+	}
+
+	b := &BigO{
+		active:      true,
+		rank:        rank,
+		label:       fmt.Sprintf("O(n^%g)", degree),
+		description: fmt.Sprintf("An algorithm with O(n^%g) complexity has a runtime that grows polynomially with the input size, with degree %g.", degree, degree),
+
+		scalingCutoff: 1000000,
+
+		floatCutoffMin: 1,
+		floatCutoffMax: floatCutoffMax,
+		funcFloatFloat: func(x float64) float64 {
+			return math.Pow(x, degree)
+		},
+		funcFloatBig: func(x float64) *big.Float {
+			return bigmath.PowFloat64(x, degree)
+		},
+		funcBigBig: func(x *big.Float) *big.Float {
+			return bigmath.Pow(x, big.NewFloat(degree))
+		},
+	}
+
+	if err := validateRegistration(b, withoutPolynomial(BigOOrdered)); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// polynomialRank returns the rank for O(n^degree), for a positive degree.
+// It matches Linear, Quadratic, Cubic, and Polynomial at degrees 1 through 4
+// and interpolates linearly between them, so it increases with the degree.
+// Since n^degree for any degree above 1 eventually outgrows n log n, degrees
+// between 1 and 2 rank above Linearithmic, and degrees below 1 rank between
+// Polylogarithmic and Linear. Above degree 4 the rank approaches, but stays
+// below, Exponential.
+func polynomialRank(degree float64) int {
+	var rank float64
+	switch {
+	case degree < 1:
+		rank = float64(Polylogarithmic.rank) + float64(Linear.rank-Polylogarithmic.rank)*degree
+	case degree == 1:
+		rank = float64(Linear.rank)
+	case degree < 2:
+		rank = float64(Linearithmic.rank) + float64(Quadratic.rank-Linearithmic.rank)*(degree-1)
+	case degree < 3:
+		rank = float64(Quadratic.rank) + float64(Cubic.rank-Quadratic.rank)*(degree-2)
+	case degree <= 4:
+		rank = float64(Cubic.rank) + float64(Polynomial.rank-Cubic.rank)*(degree-3)
+	default:
+		rank = float64(Exponential.rank) - float64(Exponential.rank-Polynomial.rank)/(degree-3)
+	}
+
+	return int(math.Round(rank))
+}
+
+// withoutPolynomial returns the BigOs in list other than Polynomial, the
+// class a BigO from NewPolynomialBigO is meant to replace.
+func withoutPolynomial(list []*BigO) []*BigO {
+	out := make([]*BigO, 0, len(list))
+	for _, b := range list {
+		if b != Polynomial {
+			out = append(out, b)
+		}
+	}
+
+	return out
+}
+
+// RegisterBigO adds a new BigO to the global set used by every Classifier.
+// If the BigO is active it is also added to BigOOrdered in rank order.
+//
+// The rank must not collide with any existing BigO, and the three model
+// functions must be non-nil.
+//
+// RegisterBigO is not safe for concurrent use. It modifies package level
+// state that Classify reads without locking, so it should only be called
+// during program initialization (e.g. from an init function) before any
+// classification starts.
+func RegisterBigO(o *BigO) error {
+	if err := validateRegistration(o, allBigO); err != nil {
+		return err
+	}
+
+	allBigO = append(allBigO, o)
+
+	if o.active {
+		BigOOrdered = append(BigOOrdered, o)
+		sort.Slice(BigOOrdered, func(i, j int) bool {
+			return BigOOrdered[i].rank < BigOOrdered[j].rank
+		})
+	}
+
+	return nil
+}
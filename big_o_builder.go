@@ -0,0 +1,157 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigo
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// BigOBuilder assembles a custom BigO one setting at a time. Since the fields
+// of BigO are unexported, this is how code outside this package creates a
+// complexity class to pass to RegisterBigO or Classifier.RegisterBigO. For
+// O(n^degree) classes, NewPolynomialBigO is a shortcut.
+//
+//	o, err := bigo.NewBigOBuilder().
+//		WithLabel("O(n^2 / log n)").
+//		WithRank(200).
+//		WithFloatFunc(func(x float64) float64 { return x * x / math.Log(x) }).
+//		WithCutoffs(2, math.MaxFloat64).
+//		Build()
+type BigOBuilder struct {
+	rank        int
+	label       string
+	description string
+
+	scalingCutoff  int
+	floatCutoffMin float64
+	floatCutoffMax float64
+
+	funcFloatFloat floatFloatFunc
+	funcFloatBig   floatBigFunc
+	funcBigBig     bigBigFunc
+}
+
+// NewBigOBuilder returns a builder with no input scaling and a float64 input
+// range of [1, math.MaxFloat64].
+func NewBigOBuilder() *BigOBuilder {
+	return &BigOBuilder{
+		rank:        0,
+		label:       "",
+		description: "",
+
+		scalingCutoff:  math.MaxInt64,
+		floatCutoffMin: 1,
+		floatCutoffMax: math.MaxFloat64,
+
+		funcFloatFloat: nil,
+		funcFloatBig:   nil,
+		funcBigBig:     nil,
+	}
+}
+
+// WithLabel sets the O(...) label. A label is required.
+func (o *BigOBuilder) WithLabel(label string) *BigOBuilder {
+	o.label = label
+
+	return o
+}
+
+// WithDescription sets the English description of the class.
+func (o *BigOBuilder) WithDescription(description string) *BigOBuilder {
+	o.description = description
+
+	return o
+}
+
+// WithRank sets the rank used to order the class relative to the others.
+func (o *BigOBuilder) WithRank(rank int) *BigOBuilder {
+	o.rank = rank
+
+	return o
+}
+
+// WithFloatFunc sets the model function for float64 inputs and outputs. A
+// float function is required.
+func (o *BigOBuilder) WithFloatFunc(f func(x float64) float64) *BigOBuilder {
+	o.funcFloatFloat = f
+
+	return o
+}
+
+// WithBigFuncs sets the model functions used when the input or output is
+// beyond the float64 range. Either may be nil, in which case it is derived
+// from the float function by converting through float64, so values beyond the
+// float64 range saturate.
+func (o *BigOBuilder) WithBigFuncs(floatBig func(x float64) *big.Float, bigBig func(x *big.Float) *big.Float) *BigOBuilder {
+	o.funcFloatBig = floatBig
+	o.funcBigBig = bigBig
+
+	return o
+}
+
+// WithCutoffs sets the range of inputs for which the float function returns
+// a valid float64 value.
+func (o *BigOBuilder) WithCutoffs(minN, maxN float64) *BigOBuilder {
+	o.floatCutoffMin = minN
+	o.floatCutoffMax = maxN
+
+	return o
+}
+
+// WithScalingCutoff sets the N above which inputs are pre-scaled to keep the
+// model values in range.
+func (o *BigOBuilder) WithScalingCutoff(n int) *BigOBuilder {
+	o.scalingCutoff = n
+
+	return o
+}
+
+// Build validates the settings and returns a new active BigO. It returns an
+// error if the label or float function is missing, or if the cutoffs do not
+// form a valid range.
+func (o *BigOBuilder) Build() (*BigO, error) {
+	if o.label == "" {
+		return nil, fmt.Errorf("BigO must have a label")
+	}
+
+	if o.funcFloatFloat == nil {
+		return nil, fmt.Errorf("BigO %q must have a float function", o.label)
+	}
+
+	if math.IsNaN(o.floatCutoffMin) || math.IsNaN(o.floatCutoffMax) || o.floatCutoffMin > o.floatCutoffMax {
+		return nil, fmt.Errorf("BigO %q has an invalid cutoff range [%v, %v]", o.label, o.floatCutoffMin, o.floatCutoffMax)
+	}
+
+	if o.scalingCutoff <= 0 {
+		return nil, fmt.Errorf("BigO %q scaling cutoff must be positive, got %d", o.label, o.scalingCutoff)
+	}
+
+	b := newBigO(o.rank, o.label, o.description, o.funcFloatFloat)
+	b.scalingCutoff = o.scalingCutoff
+	b.floatCutoffMin = o.floatCutoffMin
+	b.floatCutoffMax = o.floatCutoffMax
+
+	if o.funcFloatBig != nil {
+		b.funcFloatBig = o.funcFloatBig
+	}
+
+	if o.funcBigBig != nil {
+		b.funcBigBig = o.funcBigBig
+	}
+
+	return b, nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigo
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestBigOBuilder(t *testing.T) {
+	square := func(x float64) float64 { return x * x }
+
+	tests := []struct {
+		name    string
+		builder *BigOBuilder
+		wantErr bool
+	}{
+		{
+			name:    "label and func",
+			builder: NewBigOBuilder().WithLabel("O(n^2)'").WithRank(300).WithFloatFunc(square),
+			wantErr: false,
+		},
+		{
+			name: "all settings",
+			builder: NewBigOBuilder().
+				WithLabel("O(n^2)'").
+				WithDescription("quadratic again").
+				WithRank(300).
+				WithFloatFunc(square).
+				WithBigFuncs(floatBigSquare, bigBigSquare).
+				WithCutoffs(0, math.Sqrt(math.MaxFloat64)).
+				WithScalingCutoff(1e6),
+			wantErr: false,
+		},
+		{
+			name:    "missing label",
+			builder: NewBigOBuilder().WithRank(300).WithFloatFunc(square),
+			wantErr: true,
+		},
+		{
+			name:    "missing func",
+			builder: NewBigOBuilder().WithLabel("O(n^2)'").WithRank(300),
+			wantErr: true,
+		},
+		{
+			name:    "inverted cutoffs",
+			builder: NewBigOBuilder().WithLabel("O(n^2)'").WithFloatFunc(square).WithCutoffs(10, 1),
+			wantErr: true,
+		},
+		{
+			name:    "NaN cutoff",
+			builder: NewBigOBuilder().WithLabel("O(n^2)'").WithFloatFunc(square).WithCutoffs(math.NaN(), 1),
+			wantErr: true,
+		},
+		{
+			name:    "zero scaling cutoff",
+			builder: NewBigOBuilder().WithLabel("O(n^2)'").WithFloatFunc(square).WithScalingCutoff(0),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.builder.Build()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Build() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				if got != nil {
+					t.Errorf("Build() = %v, want nil on error", got)
+				}
+
+				return
+			}
+
+			if !got.active {
+				t.Errorf("Build() returned an inactive BigO")
+			}
+
+			if got.label != tt.builder.label || got.rank != tt.builder.rank {
+				t.Errorf("Build() = %q rank %d, want %q rank %d", got.label, got.rank, tt.builder.label, tt.builder.rank)
+			}
+
+			if got.floatCutoffMin != tt.builder.floatCutoffMin || got.floatCutoffMax != tt.builder.floatCutoffMax {
+				t.Errorf("Build() cutoffs = [%v, %v], want [%v, %v]", got.floatCutoffMin, got.floatCutoffMax,
+					tt.builder.floatCutoffMin, tt.builder.floatCutoffMax)
+			}
+
+			if got.funcFloatFloat(3) != 9 {
+				t.Errorf("Build() float func(3) = %v, want 9", got.funcFloatFloat(3))
+			}
+
+			if v, _ := got.funcFloatBig(3).Float64(); v != 9 {
+				t.Errorf("Build() float big func(3) = %v, want 9", v)
+			}
+
+			if v, _ := got.funcBigBig(big.NewFloat(3)).Float64(); v != 9 {
+				t.Errorf("Build() big big func(3) = %v, want 9", v)
+			}
+		})
+	}
+}
+
+func TestBigOBuilderClassify(t *testing.T) {
+	o, err := NewBigOBuilder().
+		WithLabel("O(n^1.5)").
+		WithRank(150).
+		WithFloatFunc(func(x float64) float64 { return math.Pow(x, 1.5) }).
+		Build()
+	if err != nil {
+		t.Fatalf("Build() returned error: %v", err)
+	}
+
+	c := NewClassifier()
+	if err := c.RegisterBigO(o); err != nil {
+		t.Fatalf("RegisterBigO() returned error: %v", err)
+	}
+
+	for n := 10; n <= 200; n += 10 {
+		_ = c.AddDataPoint(n, 3*math.Pow(float64(n), 1.5))
+	}
+
+	got, err := c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	if got.BigO() != o {
+		t.Errorf("Classify() = %s, want %s", got.BigO().Label(), o.Label())
+	}
+}
+
+func floatBigSquare(x float64) *big.Float {
+	v := big.NewFloat(x)
+
+	return v.Mul(v, v)
+}
+
+func bigBigSquare(x *big.Float) *big.Float {
+	return new(big.Float).Mul(x, x)
+}
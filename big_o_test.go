@@ -16,7 +16,9 @@ package bigo
 
 import (
 	"fmt"
+	"math"
 	"math/big"
+	"slices"
 	"testing"
 )
 
@@ -589,7 +591,7 @@ func TestNegativeIntegerHandling(t *testing.T) {
 			ns:      []int{-10, -5, -1},
 			vals:    []float64{10.0, 5.0, 1.0},
 			wantErr: true,
-			errMsg:  "there must be at least 3 (but preferably more) data points",
+			errMsg:  "insufficient data: there must be at least 3 (but preferably more) data points",
 		},
 		{
 			name:    "mixed positive and negative input sizes - negatives filtered out",
@@ -605,7 +607,7 @@ func TestNegativeIntegerHandling(t *testing.T) {
 			ns:      []int{0, 5},
 			vals:    []float64{0.0, 5.0},
 			wantErr: true,
-			errMsg:  "there must be at least 3 (but preferably more) data points",
+			errMsg:  "insufficient data: there must be at least 3 (but preferably more) data points",
 		},
 		{
 			name:    "zero input size - filtered out with sufficient remaining data",
@@ -687,7 +689,7 @@ func TestNegativeIntegerHandlingBig(t *testing.T) {
 				big.NewFloat(1.0),
 			},
 			wantErr: true,
-			errMsg:  "there must be at least 3 (but preferably more) data points",
+			errMsg:  "insufficient data: there must be at least 3 (but preferably more) data points",
 		},
 		{
 			name:  "mixed positive and negative input sizes big - negatives filtered out",
@@ -711,7 +713,7 @@ func TestNegativeIntegerHandlingBig(t *testing.T) {
 				big.NewFloat(5.0),
 			},
 			wantErr: true,
-			errMsg:  "there must be at least 3 (but preferably more) data points",
+			errMsg:  "insufficient data: there must be at least 3 (but preferably more) data points",
 		},
 		{
 			name:  "zero input size big - filtered out with sufficient remaining data",
@@ -790,3 +792,536 @@ func TestNegativeIntegerHandlingBig(t *testing.T) {
 		})
 	}
 }
+
+// restoreGlobalBigO snapshots the package level BigO sets and restores them
+// when the test finishes so registrations don't leak into other tests.
+func restoreGlobalBigO(t *testing.T) {
+	t.Helper()
+
+	savedAll := slices.Clone(allBigO)
+	savedOrdered := slices.Clone(BigOOrdered)
+	t.Cleanup(func() {
+		allBigO = savedAll
+		BigOOrdered = savedOrdered
+	})
+}
+
+func TestRegisterBigO(t *testing.T) {
+	restoreGlobalBigO(t)
+
+	custom := newBigO(200, "O(n^2 / log n)",
+		"A custom class between O(n log n) and O(n^2).",
+		func(x float64) float64 {
+			if x < 2 {
+				return x * x
+			}
+
+			return x * x / math.Log(x)
+		})
+
+	if err := RegisterBigO(custom); err != nil {
+		t.Fatalf("RegisterBigO() returned error: %v", err)
+	}
+
+	idx := slices.Index(BigOOrdered, custom)
+	if idx < 0 {
+		t.Fatalf("RegisterBigO() did not add %s to BigOOrdered", custom.Label())
+	}
+
+	if BigOOrdered[idx-1] != Linearithmic || BigOOrdered[idx+1] != Quadratic {
+		t.Errorf("%s is not ordered between %s and %s", custom.Label(), Linearithmic.Label(), Quadratic.Label())
+	}
+
+	c := NewClassifier()
+	for _, n := range []int{10, 100, 1000, 10000} {
+		_ = c.AddDataPoint(n, float64(n*n)/math.Log(float64(n)))
+	}
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	found := false
+	for _, r := range c.GetAllRatings() {
+		if r.BigO() == custom {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("GetAllRatings() does not include registered %s", custom.Label())
+	}
+}
+
+func TestRegisterBigOErrors(t *testing.T) {
+	restoreGlobalBigO(t)
+
+	square := func(x float64) float64 { return x * x }
+
+	tests := []struct {
+		name string
+		o    *BigO
+	}{
+		{
+			name: "nil BigO",
+			o:    nil,
+		},
+		{
+			name: "rank collision",
+			o:    newBigO(Quadratic.rank, "O(n^2)'", "collides with quadratic", square),
+		},
+		{
+			name: "already registered",
+			o:    Linear,
+		},
+		{
+			name: "nil model function",
+			o:    newBigO(300, "O(?)'", "missing model", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(allBigO)
+			if err := RegisterBigO(tt.o); err == nil {
+				t.Errorf("RegisterBigO() expected an error, got none")
+			}
+
+			if len(allBigO) != before {
+				t.Errorf("RegisterBigO() modified allBigO on error")
+			}
+		})
+	}
+}
+
+func TestRateScalesHugeValues(t *testing.T) {
+	// Linearly growing values close to math.MaxFloat64. Summing them (or
+	// their squares) in float64 overflows unless they are scaled first.
+	ns := []int{10, 20, 40, 80, 160}
+	vals := make([]float64, len(ns))
+	for i, n := range ns {
+		vals[i] = 1e306 * float64(n)
+	}
+
+	linear, err := Linear.Rate(ns, vals)
+	if err != nil {
+		t.Fatalf("Linear.Rate() returned error: %v", err)
+	}
+
+	if math.Abs(linear.Score()-1) > 1e-9 {
+		t.Errorf("Linear.Rate() score = %v, want 1", linear.Score())
+	}
+
+	constant, err := Constant.Rate(ns, vals)
+	if err != nil {
+		t.Fatalf("Constant.Rate() returned error: %v", err)
+	}
+
+	if constant.Score() >= linear.Score() {
+		t.Errorf("Constant.Rate() score = %v, should be less than Linear score %v", constant.Score(), linear.Score())
+	}
+
+	c := NewClassifier()
+	for i, n := range ns {
+		_ = c.AddDataPoint(n, vals[i])
+	}
+
+	rating, err := c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	if rating.BigO() != Linear {
+		t.Errorf("Classify() = %s, want %s", rating.BigO().Label(), Linear.Label())
+	}
+}
+
+func TestPredictFloatLargeN(t *testing.T) {
+	// n log n stays well within float64 range for any practical N.
+	const n = 10_000_000
+	want := float64(n) * math.Log(n)
+	if got := Linearithmic.predictFloat(n); math.Abs(got-want) > 1e-6*want {
+		t.Errorf("Linearithmic.predictFloat(%d) = %v, want %v", n, got, want)
+	}
+}
+
+func TestRateWeighted(t *testing.T) {
+	ns := []int{10, 20, 30, 40, 50}
+	vals := []float64{10, 20, 30, 40, 500}
+
+	unweighted, err := Linear.Rate(ns, vals)
+	if err != nil {
+		t.Fatalf("Rate() returned error: %v", err)
+	}
+
+	uniform, err := Linear.RateWeighted(ns, vals, []float64{2, 2, 2, 2, 2})
+	if err != nil {
+		t.Fatalf("RateWeighted() returned error: %v", err)
+	}
+
+	if math.Abs(uniform.Score()-unweighted.Score()) > 1e-12 {
+		t.Errorf("RateWeighted() with uniform weights = %v, want %v", uniform.Score(), unweighted.Score())
+	}
+
+	downweighted, err := Linear.RateWeighted(ns, vals, []float64{1, 1, 1, 1, 0.001})
+	if err != nil {
+		t.Fatalf("RateWeighted() returned error: %v", err)
+	}
+
+	if downweighted.Score() <= unweighted.Score() {
+		t.Errorf("RateWeighted() downweighting the outlier = %v, want more than %v", downweighted.Score(), unweighted.Score())
+	}
+
+	errTests := []struct {
+		name    string
+		weights []float64
+	}{
+		{name: "length mismatch", weights: []float64{1, 1}},
+		{name: "zero weight", weights: []float64{1, 1, 0, 1, 1}},
+		{name: "negative weight", weights: []float64{1, 1, -1, 1, 1}},
+	}
+
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Linear.RateWeighted(ns, vals, tt.weights); err == nil {
+				t.Errorf("RateWeighted(%v) should have returned an error", tt.weights)
+			}
+		})
+	}
+}
+
+func TestNewPolynomialBigO(t *testing.T) {
+	tests := []struct {
+		degree    float64
+		wantLabel string
+		wantRank  int
+	}{
+		{degree: 0.5, wantLabel: "O(n^0.5)", wantRank: 24},
+		{degree: 1.5, wantLabel: "O(n^1.5)", wantRank: 192},
+		{degree: 3.5, wantLabel: "O(n^3.5)", wantRank: 768},
+		{degree: 4, wantLabel: "O(n^4)", wantRank: Polynomial.rank},
+		{degree: 5, wantLabel: "O(n^5)", wantRank: 1536},
+		{degree: 10, wantLabel: "O(n^10)", wantRank: 1902},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantLabel, func(t *testing.T) {
+			b, err := NewPolynomialBigO(tt.degree)
+			if err != nil {
+				t.Fatalf("NewPolynomialBigO(%v) returned error: %v", tt.degree, err)
+			}
+
+			if b.Label() != tt.wantLabel {
+				t.Errorf("NewPolynomialBigO(%v).Label() = %q, want %q", tt.degree, b.Label(), tt.wantLabel)
+			}
+
+			if b.rank != tt.wantRank {
+				t.Errorf("NewPolynomialBigO(%v).rank = %d, want %d", tt.degree, b.rank, tt.wantRank)
+			}
+
+			// The model must be finite right up to the cutoff.
+			if got := b.funcFloatFloat(b.floatCutoffMax); math.IsInf(got, 0) || math.IsNaN(got) {
+				t.Errorf("NewPolynomialBigO(%v) at floatCutoffMax %v = %v, want finite", tt.degree, b.floatCutoffMax, got)
+			}
+
+			if got, want := b.predictFloat(10), math.Pow(10, tt.degree); math.Abs(got-want) > 1e-9*want {
+				t.Errorf("NewPolynomialBigO(%v).predictFloat(10) = %v, want %v", tt.degree, got, want)
+			}
+
+			gotBig, _ := b.funcBigBig(big.NewFloat(10)).Float64()
+			if want := math.Pow(10, tt.degree); math.Abs(gotBig-want) > 1e-9*want {
+				t.Errorf("NewPolynomialBigO(%v).funcBigBig(10) = %v, want %v", tt.degree, gotBig, want)
+			}
+		})
+	}
+}
+
+func TestNewPolynomialBigOErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		degree float64
+	}{
+		{name: "zero", degree: 0},
+		{name: "negative", degree: -2},
+		{name: "NaN", degree: math.NaN()},
+		{name: "infinite", degree: math.Inf(1)},
+		{name: "collides with linear", degree: 1},
+		{name: "collides with quadratic", degree: 2},
+		{name: "collides with cubic", degree: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if b, err := NewPolynomialBigO(tt.degree); err == nil {
+				t.Errorf("NewPolynomialBigO(%v) = %s, should have returned an error", tt.degree, b.Label())
+			}
+		})
+	}
+}
+
+func TestPolynomialRank(t *testing.T) {
+	anchors := []struct {
+		degree float64
+		want   *BigO
+	}{
+		{degree: 1, want: Linear},
+		{degree: 2, want: Quadratic},
+		{degree: 3, want: Cubic},
+		{degree: 4, want: Polynomial},
+	}
+
+	for _, a := range anchors {
+		if got := polynomialRank(a.degree); got != a.want.rank {
+			t.Errorf("polynomialRank(%v) = %d, want %s's rank %d", a.degree, got, a.want.Label(), a.want.rank)
+		}
+	}
+
+	prev := polynomialRank(0.5)
+	for degree := 0.75; degree <= 6; degree += 0.25 {
+		got := polynomialRank(degree)
+		if got <= prev {
+			t.Errorf("polynomialRank(%v) = %d, want more than %d for degree %v", degree, got, prev, degree-0.25)
+		}
+
+		prev = got
+	}
+
+	// Sublinear degrees order below Linear, superlinear ones above
+	// Linearithmic, and every degree below Exponential.
+	if got := polynomialRank(0.9); got <= Polylogarithmic.rank || got >= Linear.rank {
+		t.Errorf("polynomialRank(0.9) = %d, want between %d and %d", got, Polylogarithmic.rank, Linear.rank)
+	}
+
+	if got := polynomialRank(1.1); got <= Linearithmic.rank || got >= Quadratic.rank {
+		t.Errorf("polynomialRank(1.1) = %d, want between %d and %d", got, Linearithmic.rank, Quadratic.rank)
+	}
+
+	if got := polynomialRank(50); got >= Exponential.rank {
+		t.Errorf("polynomialRank(50) = %d, want below %d", got, Exponential.rank)
+	}
+}
+
+func TestRanks(t *testing.T) {
+	tests := []struct {
+		name string
+		vals []float64
+		want []float64
+	}{
+		{name: "empty", vals: []float64{}, want: []float64{}},
+		{name: "sorted", vals: []float64{1, 2, 3}, want: []float64{1, 2, 3}},
+		{name: "unsorted", vals: []float64{30, 10, 20}, want: []float64{3, 1, 2}},
+		{name: "ties share the average rank", vals: []float64{5, 1, 5, 5, 9}, want: []float64{3, 1, 3, 3, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ranks(tt.vals); !slices.Equal(got, tt.want) {
+				t.Errorf("ranks(%v) = %v, want %v", tt.vals, got, tt.want)
+			}
+
+			valsBig := make([]*big.Float, len(tt.vals))
+			for i, v := range tt.vals {
+				valsBig[i] = big.NewFloat(v)
+			}
+
+			if got := ranksBig(valsBig); !slices.Equal(got, tt.want) {
+				t.Errorf("ranksBig(%v) = %v, want %v", tt.vals, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPredict(t *testing.T) {
+	tests := []struct {
+		name string
+		bigO *BigO
+		ns   []int
+		want []float64
+	}{
+		{name: "linear", bigO: Linear, ns: []int{1, 10, 100}, want: []float64{1, 10, 100}},
+		{name: "quadratic", bigO: Quadratic, ns: []int{3, 4}, want: []float64{9, 16}},
+		{name: "below the minimum cutoff", bigO: Log, ns: []int{0, -3}, want: []float64{0, 0}},
+		{name: "above the maximum cutoff", bigO: Exponential, ns: []int{10, 2000}, want: []float64{1024, math.Inf(1)}},
+		{name: "empty", bigO: Linear, ns: []int{}, want: []float64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.bigO.Predict(tt.ns); !slices.Equal(got, tt.want) {
+				t.Errorf("%s.Predict(%v) = %v, want %v", tt.bigO.Label(), tt.ns, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPredictScaled(t *testing.T) {
+	tests := []struct {
+		name string
+		bigO *BigO
+		ns   []int
+		vals []float64
+		want []float64
+	}{
+		{
+			name: "exact multiple",
+			bigO: Quadratic,
+			ns:   []int{1, 2, 3},
+			vals: []float64{5, 20, 45},
+			want: []float64{5, 20, 45},
+		},
+		{
+			name: "least squares through the origin",
+			bigO: Linear,
+			ns:   []int{1, 2},
+			vals: []float64{1, 3},
+			// c = (1·1 + 2·3) / (1 + 4) = 1.4
+			want: []float64{1.4, 2.8},
+		},
+		{
+			name: "beyond float64 falls back to big.Float",
+			bigO: Exponential,
+			ns:   []int{10, 11, 1030},
+			vals: []float64{1024e-300, 2048e-300, 0},
+			want: []float64{1024e-300, 2048e-300, math.Pow(2, 1000) * 1e-300 * math.Pow(2, 30)},
+		},
+		{
+			name: "still too large after scaling",
+			bigO: Exponential,
+			ns:   []int{10, 5000},
+			vals: []float64{1024, 0},
+			want: []float64{1024, math.Inf(1)},
+		},
+		{
+			name: "length mismatch",
+			bigO: Linear,
+			ns:   []int{1, 2},
+			vals: []float64{1},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.bigO.PredictScaled(tt.ns, tt.vals)
+			if len(got) != len(tt.want) || (got == nil) != (tt.want == nil) {
+				t.Fatalf("%s.PredictScaled() = %v, want %v", tt.bigO.Label(), got, tt.want)
+			}
+
+			for i := range got {
+				if got[i] != tt.want[i] && math.Abs(got[i]-tt.want[i]) > 1e-9*math.Abs(tt.want[i]) {
+					t.Errorf("%s.PredictScaled()[%d] = %v, want %v", tt.bigO.Label(), i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFlatClassesSmallN(t *testing.T) {
+	ns := []int{1, 2, 3, 4, 8}
+
+	tests := []struct {
+		bigO *BigO
+		want []float64
+	}{
+		{
+			bigO: Constant,
+			want: []float64{1, 1, 1, 1, 1},
+		},
+		{
+			bigO: InverseAckerman,
+			want: []float64{1, 1, 2, 2, 3},
+		},
+		{
+			// 1 and 2 are below e, where log log n is 0, so they are
+			// clamped to 0 rather than -Inf and -0.37.
+			bigO: LogLog,
+			want: []float64{0, 0, math.Log(math.Log(3)), math.Log(math.Log(4)), math.Log(math.Log(8))},
+		},
+		{
+			// 1 is the cutoff itself and log 1 is already 0.
+			bigO: Log,
+			want: []float64{0, math.Log(2), math.Log(3), math.Log(4), math.Log(8)},
+		},
+		{
+			bigO: Polylogarithmic,
+			want: []float64{0, math.Pow(math.Log(2), 4), math.Pow(math.Log(3), 4), math.Pow(math.Log(4), 4), math.Pow(math.Log(8), 4)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.bigO.Label(), func(t *testing.T) {
+			got := tt.bigO.Predict(ns)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("%s.Predict(%v) = %v, want %v", tt.bigO.Label(), ns, got, tt.want)
+			}
+
+			// The 0 a clamped N is predicted as must match the model at
+			// the cutoff, or small N add a jump to the curve. Constant and
+			// α(n) never clamp an N of 1 or more.
+			if tt.bigO != Constant && tt.bigO != InverseAckerman {
+				if v := tt.bigO.funcFloatFloat(tt.bigO.floatCutoffMin); math.Abs(v) > 1e-12 {
+					t.Errorf("%s model at floatCutoffMin %v = %v, want 0", tt.bigO.Label(), tt.bigO.floatCutoffMin, v)
+				}
+			}
+
+			if !slices.IsSorted(got) {
+				t.Errorf("%s.Predict(%v) = %v, want non-decreasing", tt.bigO.Label(), ns, got)
+			}
+		})
+	}
+}
+
+func TestRateFlatClassesSmallN(t *testing.T) {
+	ns := []int{1, 2, 3, 4, 8, 16, 32, 64, 128, 256, 512, 1024}
+
+	tests := []struct {
+		name string
+		f    func(n int) float64
+		want *BigO
+	}{
+		{
+			name: "constant",
+			f:    func(n int) float64 { return 50 + 0.01*math.Sin(float64(n)) },
+			want: Constant,
+		},
+		{
+			name: "log",
+			f:    func(n int) float64 { return 20 + 7*math.Log(float64(n)) },
+			want: Log,
+		},
+		{
+			name: "log log",
+			f:    func(n int) float64 { return 20 + 7*math.Log(math.Max(1, math.Log(float64(n)))) },
+			want: LogLog,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vals := make([]float64, len(ns))
+			for i, n := range ns {
+				vals[i] = tt.f(n)
+			}
+
+			var best *Rating
+			for _, b := range []*BigO{Constant, LogLog, Log, Polylogarithmic, Linear} {
+				r, err := b.Rate(ns, vals)
+				if err != nil {
+					t.Fatalf("%s.Rate() returned error: %v", b.Label(), err)
+				}
+
+				if math.IsNaN(r.Score()) {
+					t.Errorf("%s.Rate() score is NaN with N of 1 and 2", b.Label())
+				}
+
+				if best == nil || r.Score() > best.Score() {
+					best = r
+				}
+			}
+
+			if best.BigO() != tt.want {
+				t.Errorf("best rating = %s, want %s", best.BigO().Label(), tt.want.Label())
+			}
+		})
+	}
+}
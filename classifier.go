@@ -15,15 +15,26 @@
 package bigo
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"math"
 	"math/big"
+	"math/rand/v2"
 	"os"
+	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"testing"
+
+	"github.com/rsned/stats/correlation"
 )
 
 // Classifier is used to Classify a set of data points to find the rating they
@@ -42,6 +53,45 @@ type Classifier struct {
 
 	// ratings is the set of all ratings for this data.
 	ratings []*Rating
+
+	// descriptionFunc, if set, is used by Summary to describe the winning
+	// BigO in place of its built-in description.
+	descriptionFunc func(*BigO) string
+
+	// baseline, if set, holds reference measurements (e.g., a no-op run of
+	// the benchmark harness) whose mean at each matching N is subtracted
+	// from this Classifier's mean values before fitting.
+	baseline *Classifier
+
+	// dropFirstSample, if set, discards the first value recorded at each N
+	// that has more than one value before the values are aggregated.
+	dropFirstSample bool
+
+	// weightSums holds the total weight of the values at each N that has
+	// had a weighted value added. Values without an explicit weight count
+	// as 1, and an N without an entry has a weight of 1 per value.
+	weightSums map[int]float64
+
+	// polynomial, if set, is used in place of Polynomial when classifying.
+	polynomial *BigO
+
+	// correlationMethod is used to score each BigO against the data.
+	correlationMethod correlation.Type
+
+	// minDataPoints is the number of distinct N required to Classify.
+	minDataPoints int
+
+	// aggregation reduces the values at each N before rating in Classify.
+	aggregation AggregationMode
+
+	// csvAllValues makes the CSV loaders read every column after the first.
+	csvAllValues bool
+
+	// includeInverseAckermann adds InverseAckerman to the BigOs rated.
+	includeInverseAckermann bool
+
+	// custom holds the BigOs added with RegisterBigO for this Classifier.
+	custom []*BigO
 }
 
 // NewClassifier creates a new Classifier.
@@ -52,7 +102,124 @@ func NewClassifier() *Classifier {
 		classified: false,
 		rating:     defaultRating,
 		ratings:    make([]*Rating, 0),
+
+		descriptionFunc: nil,
+		baseline:        nil,
+		dropFirstSample: false,
+		weightSums:      nil,
+		polynomial:      nil,
+
+		correlationMethod: correlation.Pearson,
+		minDataPoints:     defaultMinDataPoints,
+		aggregation:       AggregationMean,
+		csvAllValues:      false,
+
+		includeInverseAckermann: false,
+		custom:                  nil,
+	}
+}
+
+// Reset clears all data, ratings, and settings so the Classifier is
+// equivalent to one freshly returned by NewClassifier. This allows a single
+// Classifier to be reused for successive independent datasets. It is safe to
+// call on an empty Classifier.
+func (o *Classifier) Reset() {
+	*o = *NewClassifier()
+}
+
+// deepCopy returns a new Classifier with its own copies of the data and
+// classification results. The description function and baseline are
+// configuration and are shared rather than copied.
+func (o *Classifier) deepCopy() *Classifier {
+	data := make(map[int][]float64, len(o.data))
+	for N, vals := range o.data {
+		data[N] = slices.Clone(vals)
+	}
+
+	dataBig := make(map[int][]*big.Float, len(o.dataBig))
+	for N, vals := range o.dataBig {
+		copied := make([]*big.Float, len(vals))
+		for i, v := range vals {
+			copied[i] = new(big.Float).Copy(v)
+		}
+
+		dataBig[N] = copied
+	}
+
+	return &Classifier{
+		data:       data,
+		dataBig:    dataBig,
+		classified: o.classified,
+		rating:     o.rating,
+		ratings:    slices.Clone(o.ratings),
+
+		descriptionFunc: o.descriptionFunc,
+		baseline:        o.baseline,
+		dropFirstSample: o.dropFirstSample,
+		weightSums:      maps.Clone(o.weightSums),
+		polynomial:      o.polynomial,
+
+		correlationMethod: o.correlationMethod,
+		minDataPoints:     o.minDataPoints,
+		aggregation:       o.aggregation,
+		csvAllValues:      o.csvAllValues,
+
+		includeInverseAckermann: o.includeInverseAckermann,
+		custom:                  slices.Clone(o.custom),
+	}
+}
+
+// Clone returns an independent copy of the Classifier, including its data,
+// configuration, and classification results. Adding data to or classifying
+// either one afterwards does not affect the other, so a common base of data
+// can be forked to try different analyses, such as outlier removal
+// strategies, without reloading it.
+func (o *Classifier) Clone() *Classifier {
+	return o.deepCopy()
+}
+
+// Snapshot holds a saved copy of a Classifier's data, configuration, and
+// classification results that can be returned to with Restore.
+type Snapshot struct {
+	state *Classifier
+}
+
+// Snapshot captures the current data, configuration, and classification
+// results so that later changes (e.g., trimming outliers or removing points)
+// can be undone with Restore. Later changes to the Classifier do not affect
+// the Snapshot.
+func (o *Classifier) Snapshot() *Snapshot {
+	return &Snapshot{state: o.deepCopy()}
+}
+
+// Restore returns the Classifier to the state captured in the given
+// Snapshot. Unlike making a new Classifier, this mutates the receiver in
+// place. The Snapshot is left unchanged and may be restored again. Passing
+// nil does nothing.
+func (o *Classifier) Restore(s *Snapshot) {
+	if s == nil || s.state == nil {
+		return
+	}
+
+	*o = *s.state.deepCopy()
+}
+
+// SetDescriptionFunc sets the function used by Summary to describe the
+// winning BigO. This allows per-report customization (e.g., space vs time
+// wording, or other languages) while leaving the shared BigO instances
+// untouched. Passing nil restores the built-in descriptions.
+func (o *Classifier) SetDescriptionFunc(f func(*BigO) string) {
+	o.descriptionFunc = f
+}
+
+// description returns the description of the given BigO using the custom
+// description function if one has been set.
+func (o *Classifier) description(b *BigO) string {
+	if o.descriptionFunc != nil {
+		return o.descriptionFunc(b)
 	}
+
+	return b.Description()
 }
 
 // AddDataPoint adds the given values to the data.
@@ -67,11 +234,67 @@ func (o *Classifier) AddDataPoint(n int, values ...float64) error {
 		o.data = make(map[int][]float64)
 	}
 
+	if _, ok := o.weightSums[n]; ok {
+		o.weightSums[n] += float64(len(values))
+	}
+
 	o.data[n] = append(o.data[n], values...)
 
 	return nil
 }
 
+// AddWeightedDataPoint adds the given values to the data with the given
+// weight, which sets how strongly they count when rating each BigO relative
+// to values added with AddDataPoint, which have a weight of 1. Use weights
+// below 1 to downweight noisy measurements, such as those at tiny N.
+//
+// When rating, each N is weighted by the average weight of its values and a
+// weighted Pearson correlation is used. The weight must be positive; an error
+// is returned and nothing is stored otherwise. Non-positive input sizes
+// (n <= 0) are ignored and not added to the dataset. Weights are not
+// supported together with big.Float data; Classify returns an error wrapping
+// ErrBigFloatUnsupported if both are present.
+func (o *Classifier) AddWeightedDataPoint(n int, weight float64, values ...float64) error {
+	if !(weight > 0) || math.IsInf(weight, 0) {
+		return fmt.Errorf("weight %v must be a positive finite number", weight)
+	}
+
+	if n <= 0 {
+		return nil
+	}
+
+	if o.weightSums == nil {
+		o.weightSums = make(map[int]float64)
+	}
+
+	if _, ok := o.weightSums[n]; !ok {
+		// Values already stored at this N were unweighted.
+		o.weightSums[n] = float64(len(o.data[n]))
+	}
+
+	// AddDataPoint counts each value with a weight of 1, so only add the
+	// difference here.
+	o.weightSums[n] += (weight - 1) * float64(len(values))
+
+	return o.AddDataPoint(n, values...)
+}
+
+// weightsFor returns the average weight of the values at each of the given
+// N, and whether any of them differ from the default weight of 1.
+func (o *Classifier) weightsFor(Ns []int) ([]float64, bool) {
+	weights := make([]float64, len(Ns))
+	weighted := false
+	for i, N := range Ns {
+		weights[i] = 1
+		if sum, ok := o.weightSums[N]; ok && len(o.data[N]) > 0 {
+			weights[i] = sum / float64(len(o.data[N]))
+			weighted = weighted || weights[i] != 1
+		}
+	}
+
+	return weights, weighted
+}
+
 // AddDataPointBig adds the given values to the data.
 // Non-positive input sizes (n <= 0) are ignored and not added to the dataset.
 func (o *Classifier) AddDataPointBig(n int, values ...*big.Float) error {
@@ -96,7 +319,7 @@ func (o *Classifier) AddDataPoints(n []int, values [][]float64) error {
 	}
 
 	if len(n) != len(values) {
-		return fmt.Errorf("sizes and corresponding values must be the same length")
+		return fmt.Errorf("%w: sizes and corresponding values must be the same length", ErrLengthMismatch)
 	}
 
 	for i, n := range n {
@@ -116,7 +339,7 @@ func (o *Classifier) AddDataPointsBig(n []int, values [][]*big.Float) error {
 	}
 
 	if len(n) != len(values) {
-		return fmt.Errorf("sizes and corresponding values must be the same length")
+		return fmt.Errorf("%w: sizes and corresponding values must be the same length", ErrLengthMismatch)
 	}
 
 	for i, n := range n {
@@ -129,6 +352,88 @@ func (o *Classifier) AddDataPointsBig(n []int, values [][]*big.Float) error {
 	return nil
 }
 
+// RemoveDataPoint deletes every value recorded for N, both float64 and
+// big.Float, e.g., to drop a size that was measured under thermal throttling.
+// It reports whether anything was removed. When it was, any previous
+// classification is discarded so stale ratings are not returned, and Classify
+// must be called again. An N that was never added is a no-op.
+func (o *Classifier) RemoveDataPoint(n int) bool {
+	_, inData := o.data[n]
+	_, inDataBig := o.dataBig[n]
+	if !inData && !inDataBig {
+		return false
+	}
+
+	delete(o.data, n)
+	delete(o.dataBig, n)
+	delete(o.weightSums, n)
+	o.clearClassification()
+
+	return true
+}
+
+// TrimOutliers drops the float64 values at each N whose z-score among the
+// values at that N (their distance from the mean in population standard
+// deviations) exceeds zThreshold, and returns how many were removed. This
+// removes the occasional wildly high timing from benchmark noise that drags
+// the correlation down; a threshold around 3 is typical. An N with a single
+// value, or whose values are all equal, is left alone.
+//
+// A single outlier among k values can have a z-score of at most
+// (k-1)/sqrt(k), so a threshold of 3 needs at least 11 values at an N to
+// trim anything. The data is changed in place, so take a Snapshot first to
+// be able to undo it. If anything is removed, the previous classification is
+// discarded and Classify must be called again. Values added with
+// AddWeightedDataPoint keep the average weight of their N.
+func (o *Classifier) TrimOutliers(zThreshold float64) int {
+	removed := 0
+	for N, vals := range o.data {
+		if len(vals) < 2 {
+			continue
+		}
+
+		m := mean(vals)
+		variance := 0.0
+		for _, v := range vals {
+			variance += (v - m) * (v - m)
+		}
+
+		stddev := math.Sqrt(variance / float64(len(vals)))
+		if stddev == 0 {
+			continue
+		}
+
+		kept := slices.DeleteFunc(vals, func(v float64) bool {
+			return math.Abs(v-m)/stddev > zThreshold
+		})
+
+		if len(kept) == len(vals) {
+			continue
+		}
+
+		if sum, ok := o.weightSums[N]; ok {
+			o.weightSums[N] = sum * float64(len(kept)) / float64(len(vals))
+		}
+
+		removed += len(vals) - len(kept)
+		o.data[N] = kept
+	}
+
+	if removed > 0 {
+		o.clearClassification()
+	}
+
+	return removed
+}
+
+// clearClassification discards the results of the last Classify after the
+// data has changed underneath them.
+func (o *Classifier) clearClassification() {
+	o.classified = false
+	o.rating = defaultRating
+	o.ratings = make([]*Rating, 0)
+}
+
 // AddBenchmarkResult adds the result of a benchmark test to the data.
 // It extracts the nanoseconds per operation from the BenchmarkResult and adds it
 // as a data point using the number of iterations (N) as the input size.
@@ -164,11 +469,113 @@ func (o *Classifier) AddBenchmarkResult(result testing.BenchmarkResult) error {
 	return o.AddDataPoint(inputSize, timeValue)
 }
 
-// readCSV reads and parses a 2-column delimiter separated file.
-// The header parameter controls whether the first line of the file is a header
-// or not and should be skipped.
-// Returns two slices: one for the N values and one for the corresponding measurements.
-// Non-positive input sizes are filtered out during parsing.
+// RunAndClassify runs a benchmark for each of the given sizes, records the
+// nanoseconds per operation against the size as the N, and then classifies
+// the data. fn returns the benchmark function for a size, and is expected to
+// do work proportional to that size on each iteration of b.Loop or b.N.
+//
+// Each benchmark runs for the default benchmark time, so the call takes at
+// least that long per size. An error is returned if fn is nil, if a benchmark
+// fails or is skipped without running, or if Classify does.
+//
+// Usage example:
+//
+//	rating, err := classifier.RunAndClassify([]int{1000, 2000, 4000, 8000},
+//	    func(n int) func(b *testing.B) {
+//	        vals := rand.Perm(n)
+//	        return func(b *testing.B) {
+//	            for b.Loop() {
+//	                slices.Sort(slices.Clone(vals))
+//	            }
+//	        }
+//	    })
+func (o *Classifier) RunAndClassify(sizes []int, fn func(n int) func(b *testing.B)) (*Rating, error) {
+	if fn == nil {
+		return defaultRating, fmt.Errorf("cannot run a nil benchmark function")
+	}
+
+	for _, n := range sizes {
+		result := testing.Benchmark(fn(n))
+		if result.N <= 0 {
+			return defaultRating, fmt.Errorf("benchmark for n=%d did not run", n)
+		}
+
+		if err := o.AddDataPoint(n, perOp(uint64(result.T.Nanoseconds()), result.N)); err != nil {
+			return defaultRating, err
+		}
+	}
+
+	return o.Classify()
+}
+
+// AddBenchmarkResultBytes adds the bytes allocated per operation from the
+// result of a benchmark to the data, so the space complexity of a function can
+// be classified the same way AddBenchmarkResult classifies its running time.
+// The N is the number of iterations, as with AddBenchmarkResult, and the value
+// is the float64 average MemBytes per operation. Non-positive iteration counts
+// are ignored.
+//
+// The benchmark must report allocations, e.g. with b.ReportAllocs or the
+// -benchmem flag, or every value will be 0.
+func (o *Classifier) AddBenchmarkResultBytes(result testing.BenchmarkResult) error {
+	return o.AddDataPoint(result.N, perOp(result.MemBytes, result.N))
+}
+
+// AddBenchmarkResultAllocs adds the allocations per operation from the result
+// of a benchmark to the data. It is the same as AddBenchmarkResultBytes but
+// uses MemAllocs, the number of allocations, rather than their size.
+func (o *Classifier) AddBenchmarkResultAllocs(result testing.BenchmarkResult) error {
+	return o.AddDataPoint(result.N, perOp(result.MemAllocs, result.N))
+}
+
+// perOp returns the float64 average of total over n operations, or 0 if n is
+// not positive. Float division avoids the truncation of the integer per op
+// methods on testing.BenchmarkResult.
+func perOp(total uint64, n int) float64 {
+	if n <= 0 {
+		return 0
+	}
+
+	return float64(total) / float64(n)
+}
+
+// Records returns every stored (N, value) pair as a flat list sorted by N.
+// An N with multiple values expands into one record per value, in the order
+// the values were added. Any big.Float values are converted to the nearest
+// float64 and follow the float64 values for the same N.
+//
+// The returned slice is a copy and can be safely modified.
+func (o *Classifier) Records() []DataPoint {
+	Ns := make([]int, 0, len(o.data)+len(o.dataBig))
+	for n := range o.data {
+		Ns = append(Ns, n)
+	}
+
+	for n := range o.dataBig {
+		if _, ok := o.data[n]; !ok {
+			Ns = append(Ns, n)
+		}
+	}
+
+	slices.Sort(Ns)
+
+	var records []DataPoint
+	for _, n := range Ns {
+		for _, v := range o.data[n] {
+			records = append(records, DataPoint{N: n, Value: v})
+		}
+
+		for _, v := range o.dataBig[n] {
+			f, _ := v.Float64()
+			records = append(records, DataPoint{N: n, Value: f})
+		}
+	}
+
+	return records
+}
+
+// readCSV reads and parses a 2-column delimiter separated file with
+// parseCSV.
 func readCSV(path string, header bool, delimiter rune) ([]int, []float64, error) {
 	csvFile, err := os.Open(path)
 	if err != nil {
@@ -179,8 +586,44 @@ func readCSV(path string, header bool, delimiter rune) ([]int, []float64, error)
 		_ = csvFile.Close()
 	}()
 
-	csvReader := csv.NewReader(csvFile)
+	ns, vals, err := parseCSV(csvFile, header, delimiter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("file %s: %w", path, err)
+	}
+
+	return ns, vals, nil
+}
+
+// parseCSV reads and parses 2-column delimiter separated data from r.
+// The header parameter controls whether the first line of the data is a header
+// or not and should be skipped.
+// Returns two slices: one for the N values and one for the corresponding measurements.
+// Non-positive input sizes are filtered out during parsing.
+func parseCSV(r io.Reader, header bool, delimiter rune) ([]int, []float64, error) {
+	ns, rows, err := parseCSVRows(r, header, delimiter, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vals := make([]float64, len(rows))
+	for i, row := range rows {
+		vals[i] = row[0]
+	}
+
+	return ns, vals, nil
+}
+
+// parseCSVRows reads and parses delimiter separated data from r like
+// parseCSV, but returns the measurements of each row as a slice. If allValues
+// is set, every column after the first is parsed as a measurement, rows may
+// have differing numbers of columns, and any column that is not a number is
+// an error. Otherwise only the second column is read and the rest ignored.
+func parseCSVRows(r io.Reader, header bool, delimiter rune, allValues bool) ([]int, [][]float64, error) {
+	csvReader := csv.NewReader(r)
 	csvReader.Comma = delimiter
+	if allValues {
+		csvReader.FieldsPerRecord = -1
+	}
 
 	records, err := csvReader.ReadAll()
 	if err != nil {
@@ -189,7 +632,7 @@ func readCSV(path string, header bool, delimiter rune) ([]int, []float64, error)
 
 	// Check there was something in there.
 	if len(records) == 0 {
-		return nil, nil, fmt.Errorf("no records found in file %s", path)
+		return nil, nil, errors.New("no records found")
 	}
 
 	if header {
@@ -197,11 +640,11 @@ func readCSV(path string, header bool, delimiter rune) ([]int, []float64, error)
 	}
 
 	var ns []int
-	var vals []float64
+	var vals [][]float64
 
 	for i, record := range records {
 		// We only care that there are at least 2 columns. Any additional
-		// columns are ignored.
+		// columns are ignored unless allValues is set.
 		if len(record) < 2 {
 			return nil, nil, fmt.Errorf("not enough columns (%d) for record %d", len(record), i)
 		}
@@ -224,31 +667,71 @@ func readCSV(path string, header bool, delimiter rune) ([]int, []float64, error)
 		// values in as big.Float if that occurs.
 
 		// TODO(rsned): Add support to try big.Float if ParseFloat fails.
-		val, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
-		if err != nil {
-			return nil, nil, fmt.Errorf("error parsing field 2 value for record %d: %w", i, err)
+		fields := record[1:2]
+		if allValues {
+			fields = record[1:]
+		}
+
+		row := make([]float64, len(fields))
+		for j, field := range fields {
+			row[j], err = strconv.ParseFloat(strings.TrimSpace(field), 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error parsing field %d value for record %d: %w", j+2, i, err)
+			}
 		}
 
 		ns = append(ns, n)
-		vals = append(vals, val)
+		vals = append(vals, row)
 	}
 
 	return ns, vals, nil
 }
 
+// SetCSVAllValues sets whether LoadCSV and LoadCSVReader read every column
+// after the first as a separate measurement for that row's N, for files that
+// hold all the runs of each N on one row, e.g., "100,1.2,1.3,1.25". In this
+// mode rows may have differing numbers of columns, and a column that is not a
+// number is a parse error rather than being ignored. The default is false,
+// which reads only the second column.
+func (o *Classifier) SetCSVAllValues(all bool) {
+	o.csvAllValues = all
+}
+
 // LoadCSV loads the data from a 2-column delimiter separated file and adds the values.
 // The header parameter controls whether the first line of the file is a header
 // or not and should be skipped. If an error occurred, no data will be loaded.
 // Any errors encountered are returned.
 // Non-positive input sizes are automatically filtered out during loading.
+// See SetCSVAllValues to read more than one measurement from each row.
 func (o *Classifier) LoadCSV(path string, header bool, delimiter rune) error {
-	ns, vals, err := readCSV(path, header, delimiter)
+	csvFile, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = csvFile.Close()
+	}()
+
+	if err := o.LoadCSVReader(csvFile, header, delimiter); err != nil {
+		return fmt.Errorf("file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// LoadCSVReader loads 2-column delimiter separated data from r, such as an
+// HTTP response body or a bytes.Buffer, and adds the values. It behaves the
+// same as LoadCSV. If an error occurred, no data will be loaded.
+// Non-positive input sizes are automatically filtered out during loading.
+func (o *Classifier) LoadCSVReader(r io.Reader, header bool, delimiter rune) error {
+	ns, vals, err := parseCSVRows(r, header, delimiter, o.csvAllValues)
 	if err != nil {
 		return err
 	}
 
 	for i, n := range ns {
-		if err := o.AddDataPoint(n, vals[i]); err != nil {
+		if err := o.AddDataPoint(n, vals[i]...); err != nil {
 			return fmt.Errorf("failed to add data point: %w", err)
 		}
 	}
@@ -256,95 +739,1467 @@ func (o *Classifier) LoadCSV(path string, header bool, delimiter rune) error {
 	return nil
 }
 
-// Classify is used to Classify the data so far and determine the most
-// Big O fit. Can be run as often as needed when more data are added.
-//
-// Common errors that can occur here are lack of distinct data points to be able
-// to analyzye, or errors in a specific BigO test.
-//
-// For every distinct N value, the values stored for it are averaged to get a working
-// value. The assumption in this package is that the user will do more than a single
-// run of their code to get real world timing results, so we average all the run values
-// for a given N.
-//
-// TODO(rsned): Add support for the big.Float values as well.
-// TODO(rsned): If there are at least 30-50 values for a given N, then we can run some
-// basic stats tests on the data points to test for outliers in the data	.
-// TODO(rsned): If the number of values for a given N are large enough,
-// should we include the option to discard the outlier in the values?
-func (o *Classifier) Classify() (*Rating, error) {
-	if len(o.data) < 3 {
-		return defaultRating, fmt.Errorf("not enough data points (%d) to Classify", len(o.data))
-	}
+// jsonDataPoint is the JSON form of the values measured at one N, as read by
+// LoadJSON.
+type jsonDataPoint struct {
+	N      int       `json:"n"`
+	Values []float64 `json:"values"`
+}
 
-	// Start with an unset ranking.
-	o.rating = &Rating{
-		bigO:  Unrated,
-		score: -1,
+// readJSON reads and parses a JSON array of objects holding an N and its
+// measured values, e.g., [{"n": 100, "values": [1.2, 1.3]}].
+// Returns two slices: one for the N values and one for the corresponding
+// measurements. Non-positive input sizes are filtered out during parsing.
+func readJSON(path string) ([]int, [][]float64, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	var Ns []int
-	// First pass through, pull out the distinct N values and order them.
-	for N := range o.data {
-		Ns = append(Ns, N)
+	var points []jsonDataPoint
+	if err := json.Unmarshal(contents, &points); err != nil {
+		return nil, nil, fmt.Errorf("error parsing JSON file %s: %w", path, err)
 	}
 
-	sort.Ints(Ns)
+	var ns []int
+	var vals [][]float64
 
-	// Second pass through, compute the average result value for the given N
-	// and store it.
-	vals := make([]float64, len(Ns))
-	for i, N := range Ns {
-		val := 0.0
-		for _, v := range o.data[N] {
-			val += v
+	for _, p := range points {
+		// Skip non-positive input sizes
+		if p.N <= 0 {
+			continue
 		}
 
-		// TODO(rsned): Check for divide by 0.
-		vals[i] = val / float64(len(o.data[N]))
+		ns = append(ns, p.N)
+		vals = append(vals, p.Values)
 	}
 
-	if len(o.dataBig) > 0 {
-		return defaultRating, fmt.Errorf("big.Float data not implemented yet")
+	return ns, vals, nil
+}
+
+// LoadJSON loads the data from a JSON file holding an array of objects with
+// an N and its measured values, e.g., [{"n": 100, "values": [1.2, 1.3]}],
+// and adds the values. If an error occurred, no data will be loaded. Any
+// errors encountered are returned. An empty array loads nothing, like a CSV
+// file with only a header row.
+// Non-positive input sizes are automatically filtered out during loading.
+func (o *Classifier) LoadJSON(path string) error {
+	ns, vals, err := readJSON(path)
+	if err != nil {
+		return err
 	}
 
-	// Reset ratings slice for fresh classification
-	o.ratings = make([]*Rating, 0)
+	for i, n := range ns {
+		if err := o.AddDataPoint(n, vals[i]...); err != nil {
+			return fmt.Errorf("failed to add data point: %w", err)
+		}
+	}
 
-	// Now for each potential BigO complexity, generate and save its ranking.
-	//
-	// TODO(rsned): Add support for the big.Float values as well.
-	var lastErr error
-	for _, b := range BigOOrdered {
-		// In some cases, to prevent huge amounts of computation in *big.Float
-		// land, it is advisable to pre-scale the values down to smaller ranges.
-		// e.g.,
-		if Ns[len(Ns)-1] > b.scalingCutoff {
-			// TODO(rsned): Scaling down Ns and vals to avoid blowout computation.
+	return nil
+}
+
+// goBenchmarkN matches the input size in a benchmark name written by
+// b.Run(fmt.Sprintf("n=%d", n), ...), e.g., BenchmarkSort/n=1000-8.
+var goBenchmarkN = regexp.MustCompile(`(?:^|[/_])n=(\d+)`)
+
+// LoadGoBenchmark loads the output of go test -bench from r, such as
+//
+//	BenchmarkFoo/n=1000-8   12345   97.3 ns/op
+//
+// and adds the ns/op of each benchmark whose name matches the regular
+// expression namePattern as a data point, using the n= value in its name as
+// the N. Lines that are not benchmark results, or have no n= value or ns/op,
+// are skipped. If an error occurred, no data will be loaded.
+// Non-positive input sizes are automatically filtered out during loading.
+func (o *Classifier) LoadGoBenchmark(r io.Reader, namePattern string) error {
+	nameRE, err := regexp.Compile(namePattern)
+	if err != nil {
+		return fmt.Errorf("invalid benchmark name pattern: %w", err)
+	}
+
+	var ns []int
+	var vals []float64
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") || !nameRE.MatchString(fields[0]) {
 			continue
 		}
 
-		rating, err := b.Rate(Ns, vals)
-		if err != nil {
-			fmt.Printf("Error ranking %s: %v\n", b.label, err)
-			lastErr = err
+		match := goBenchmarkN.FindStringSubmatch(fields[0])
+		if match == nil {
+			continue
 		}
 
-		o.ratings = append(o.ratings, rating)
+		n, err := strconv.Atoi(match[1])
+		if err != nil || n <= 0 {
+			continue
+		}
 
-		// If this score is higher than the last best, promote it to the leading result.
-		if rating.score > o.rating.score {
-			o.rating = rating
+		// The measurements follow the iteration count as value and unit
+		// pairs, e.g., "97.3 ns/op 16 B/op".
+		for i := 3; i < len(fields); i++ {
+			if fields[i] != "ns/op" {
+				continue
+			}
+
+			if val, err := strconv.ParseFloat(fields[i-1], 64); err == nil {
+				ns = append(ns, n)
+				vals = append(vals, val)
+			}
+
+			break
 		}
 	}
 
-	o.classified = true
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading benchmark output: %w", err)
+	}
+
+	for i, n := range ns {
+		if err := o.AddDataPoint(n, vals[i]); err != nil {
+			return fmt.Errorf("failed to add data point: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// scoreTieTolerance is the amount by which a score must beat the current best
+// to replace it in Classify. Curves that are identical up to a constant over
+// the range of N (e.g., O(n) and O(n log* n)) produce scores that differ only
+// by floating point rounding, and those should not decide the winner.
+const scoreTieTolerance = 1e-9
+
+// defaultMinDataPoints is the fewest distinct N that Classify accepts by
+// default. A correlation needs at least 3 points to mean anything.
+const defaultMinDataPoints = 3
+
+// SetMinDataPoints sets the number of distinct N values required before
+// Classify will run. The default is 3, the fewest a correlation needs, but
+// reliably telling adjacent classes such as O(n) and O(n log n) apart takes
+// more. Values below 3 are rejected with an error.
+func (o *Classifier) SetMinDataPoints(n int) error {
+	if n < defaultMinDataPoints {
+		return fmt.Errorf("minimum data points %d must be at least %d", n, defaultMinDataPoints)
+	}
+
+	o.minDataPoints = n
+
+	return nil
+}
+
+// Classify is used to Classify the data so far and determine the most
+// Big O fit. Can be run as often as needed when more data are added.
+//
+// Common errors that can occur here are lack of distinct data points to be able
+// to analyzye (fewer than SetMinDataPoints, 3 by default), or errors in a
+// specific BigO test.
+//
+// For every distinct N value, the values stored for it are averaged to get a working
+// value. The assumption in this package is that the user will do more than a single
+// run of their code to get real world timing results, so we average all the run values
+// for a given N. SetAggregation can choose a different reduction.
+//
+// If any big.Float data has been added, all float64 values are promoted to
+// big.Float and every BigO is rated with RateBig so mixed data can be
+// classified together. Weights and aggregation modes other than
+// AggregationMean are not supported for such data, and an error wrapping
+// ErrBigFloatUnsupported is returned if either is set.
+//
+// If the data fails IsMonotonic, the best-effort rating is still returned
+// but its NonMonotonic flag is set, and Summary notes it. Constant time data
+// is exempt since its values are expected to wander with noise.
+//
+// TODO(rsned): If there are at least 30-50 values for a given N, then we can run some
+// basic stats tests on the data points to test for outliers in the data	.
+// TODO(rsned): If the number of values for a given N are large enough,
+// should we include the option to discard the outlier in the values?
+func (o *Classifier) Classify() (*Rating, error) {
+	if numNs := o.numDistinctNs(); numNs < o.minDataPoints {
+		return defaultRating, fmt.Errorf("%w: not enough data points (%d) to Classify, need at least %d", ErrInsufficientData, numNs, o.minDataPoints)
+	}
+
+	Ns, vals := o.aggregatedValues()
+	rate := func(b *BigO) (*Rating, error) {
+		return b.rateWith(Ns, vals, o.correlationMethod)
+	}
+
+	if weights, weighted := o.weightsFor(Ns); weighted {
+		rate = func(b *BigO) (*Rating, error) {
+			return b.rateWeightedWith(Ns, vals, weights, o.correlationMethod)
+		}
+	}
+
+	if len(o.dataBig) > 0 {
+		if o.aggregation != AggregationMean {
+			return defaultRating, fmt.Errorf("%w: big.Float data is only classified with AggregationMean", ErrBigFloatUnsupported)
+		}
+
+		var valsBig []*big.Float
+		Ns, valsBig = o.meanValuesBig()
+		if _, weighted := o.weightsFor(Ns); weighted {
+			return defaultRating, fmt.Errorf("%w: weighted data points can not be classified together with big.Float data", ErrBigFloatUnsupported)
+		}
+
+		rate = func(b *BigO) (*Rating, error) {
+			return b.rateBigWith(Ns, valsBig, o.correlationMethod)
+		}
+	}
+
+	// An N added with no values is counted above but dropped when the
+	// values are aggregated, so check again with what is left.
+	if numNs := numDistinct(Ns); numNs < o.minDataPoints {
+		return defaultRating, fmt.Errorf("%w: not enough data points (%d) with values to Classify, need at least %d", ErrInsufficientData, numNs, o.minDataPoints)
+	}
+
+	// Start with an unset ranking.
+	o.rating = &Rating{
+		bigO:         Unrated,
+		score:        -1,
+		nonMonotonic: false,
+	}
+
+	// Reset ratings slice for fresh classification
+	o.ratings = make([]*Rating, 0)
+
+	// Now for each potential BigO complexity, generate and save its ranking.
+	var lastErr error
+	for _, b := range o.candidates() {
+		// In some cases, to prevent huge amounts of computation in *big.Float
+		// land, it is advisable to pre-scale the values down to smaller ranges.
+		// e.g.,
+		if Ns[len(Ns)-1] > b.scalingCutoff {
+			// TODO(rsned): Scaling down Ns and vals to avoid blowout computation.
+			continue
+		}
+
+		// α(n) is constant over most ranges of N, and a constant model can't
+		// be correlated with anything.
+		if b == InverseAckerman && !modelVaries(b, Ns) {
+			continue
+		}
+
+		rating, err := rate(b)
+		if err != nil {
+			fmt.Printf("Error ranking %s: %v\n", b.label, err)
+			lastErr = err
+		}
+
+		o.ratings = append(o.ratings, rating)
+
+		// If this score is higher than the last best, promote it to the
+		// leading result. Scores within scoreTieTolerance are treated as a
+		// tie, which keeps the lower ranked (simpler) BigO.
+		if rating.score > o.rating.score+scoreTieTolerance {
+			o.rating = rating
+		}
+	}
+
+	// Flag a copy so the rating stored in o.ratings, or the shared
+	// defaultRating a failed Rate returns, is left untouched.
+	if o.rating.bigO != Constant && !o.IsMonotonic() {
+		o.rating = &Rating{
+			bigO:         o.rating.bigO,
+			score:        o.rating.score,
+			nonMonotonic: true,
+		}
+	}
+
+	o.classified = true
 
 	sort.Slice(o.ratings, func(i, j int) bool {
 		return o.ratings[i].bigO.rank < o.ratings[j].bigO.rank
 	})
 
-	return o.rating, lastErr
+	return o.rating, lastErr
+}
+
+// modelVaries reports whether the BigO's model takes more than one value
+// over the given N.
+func modelVaries(b *BigO, Ns []int) bool {
+	predicteds := b.Predict(Ns)
+
+	return slices.ContainsFunc(predicteds, func(p float64) bool { return p != predicteds[0] })
+}
+
+// IsMonotonic reports whether the mean value at each N, taken in increasing
+// order of N, is never less than the mean value at the previous N. Cost
+// growth classification assumes timings generally increase with N; if they
+// fall instead (e.g., caching effects make large N faster) the fit is
+// meaningless. Data with fewer than two distinct N is trivially monotonic.
+func (o *Classifier) IsMonotonic() bool {
+	if len(o.dataBig) > 0 {
+		_, vals := o.meanValuesBig()
+		for i := 1; i < len(vals); i++ {
+			if vals[i].Cmp(vals[i-1]) < 0 {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	_, vals := o.meanValues()
+	for i := 1; i < len(vals); i++ {
+		if vals[i] < vals[i-1] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SetCorrelationMethod sets the correlation used to score each BigO against
+// the data. The default is correlation.Pearson. correlation.Spearman scores
+// the ranks of the values instead, so it is far less sensitive to outliers
+// in noisy timing data. However, since every growing BigO has the same
+// ranks, Spearman scores them all alike when the data is monotonic; it is
+// best used to confirm growth, with Pearson choosing between the classes.
+//
+// The O(1) rating is based on the coefficient of variation rather than a
+// correlation, so it is not affected. Only Pearson and Spearman are
+// supported; an error is returned for any other method.
+func (o *Classifier) SetCorrelationMethod(m correlation.Type) error {
+	if m != correlation.Pearson && m != correlation.Spearman {
+		return fmt.Errorf("correlation method %s is not supported", m)
+	}
+
+	o.correlationMethod = m
+
+	return nil
+}
+
+// SetPolynomialBigO sets the BigO to rate in place of Polynomial, whose degree
+// is fixed at 4, when classifying. Typically this comes from
+// NewPolynomialBigO, e.g., to rate data against O(n^3.5). Passing nil
+// restores Polynomial.
+//
+// Like RegisterBigO, the rank must not collide with any other BigO this
+// Classifier rates, and the three model functions must be non-nil. On error
+// the current setting is left unchanged.
+func (o *Classifier) SetPolynomialBigO(b *BigO) error {
+	if b != nil {
+		others := withoutPolynomial(o.candidates())
+		if o.polynomial != nil {
+			others = slices.DeleteFunc(others, func(c *BigO) bool { return c == o.polynomial })
+		}
+
+		if err := validateRegistration(b, append(others, o.custom...)); err != nil {
+			return err
+		}
+	}
+
+	o.polynomial = b
+
+	return nil
+}
+
+// IncludeInverseAckermann sets whether InverseAckerman, O(α(n)), is rated
+// when classifying with this Classifier. It is inactive by default since
+// α(n) is a step function that is flat almost everywhere (it is 3 for every
+// N from 8 to 2047 and 4 for every N beyond that to well past 2^63), so it
+// is easily confused with O(1) and O(log log n). When included, it is only
+// rated if α(n) changes across the N values in the data, such as for
+// union-find timings that span N=2 to N=2048 and beyond; over a range where
+// α(n) is constant, there is nothing to correlate. See DisambiguateFlat to
+// separate it from the other flat classes.
+func (o *Classifier) IncludeInverseAckermann(include bool) {
+	o.includeInverseAckermann = include
+}
+
+// RegisterBigO adds a custom BigO, such as one from BigOBuilder or
+// NewPolynomialBigO, to the set this Classifier rates when classifying. It is
+// rated like any built-in class, using its model functions and cutoffs.
+// Unlike the package level RegisterBigO, other Classifiers are unaffected.
+//
+// The rank must not collide with any BigO this Classifier rates, and the
+// three model functions must be non-nil. A BigO that is not active is not
+// rated.
+func (o *Classifier) RegisterBigO(b *BigO) error {
+	if err := validateRegistration(b, append(o.candidates(), o.custom...)); err != nil {
+		return err
+	}
+
+	o.custom = append(o.custom, b)
+
+	return nil
+}
+
+// candidates returns the BigOs to rate in rank order. This is BigOOrdered,
+// with Polynomial replaced if SetPolynomialBigO was used, InverseAckerman
+// added if IncludeInverseAckermann was set, and any active BigOs added with
+// RegisterBigO.
+func (o *Classifier) candidates() []*BigO {
+	addInverseAckermann := o.includeInverseAckermann && !slices.Contains(BigOOrdered, InverseAckerman)
+	if o.polynomial == nil && !addInverseAckermann && len(o.custom) == 0 {
+		return BigOOrdered
+	}
+
+	bigOs := make([]*BigO, 0, len(BigOOrdered)+len(o.custom)+1)
+	for _, b := range BigOOrdered {
+		if b == Polynomial && o.polynomial != nil {
+			b = o.polynomial
+		}
+
+		bigOs = append(bigOs, b)
+	}
+
+	if addInverseAckermann {
+		bigOs = append(bigOs, InverseAckerman)
+	}
+
+	for _, b := range o.custom {
+		if b.active {
+			bigOs = append(bigOs, b)
+		}
+	}
+
+	sort.SliceStable(bigOs, func(i, j int) bool {
+		return bigOs[i].rank < bigOs[j].rank
+	})
+
+	return bigOs
+}
+
+// SetDropFirstSample sets whether the first value recorded at each N is
+// discarded before aggregating. The first run of a benchmark is often an
+// outlier from cold caches, so this models the common pattern of a warmup
+// run. Only N values with more than one recorded value are affected; a lone
+// value is always kept. The stored data is unchanged, so this can be turned
+// back off.
+func (o *Classifier) SetDropFirstSample(drop bool) {
+	o.dropFirstSample = drop
+}
+
+// samples returns the float64 values to aggregate at each N, without the
+// first value at each N with more than one if dropFirstSample is set.
+func (o *Classifier) samples() map[int][]float64 {
+	if !o.dropFirstSample {
+		return o.data
+	}
+
+	return withoutFirstSample(o.data)
+}
+
+// samplesBig is the big.Float counterpart of samples.
+func (o *Classifier) samplesBig() map[int][]*big.Float {
+	if !o.dropFirstSample {
+		return o.dataBig
+	}
+
+	return withoutFirstSample(o.dataBig)
+}
+
+// withoutFirstSample returns a view of data with the first value dropped at
+// each N that has more than one value. The value slices share storage with
+// data.
+func withoutFirstSample[T any](data map[int][]T) map[int][]T {
+	trimmed := make(map[int][]T, len(data))
+	for N, vals := range data {
+		if len(vals) > 1 {
+			vals = vals[1:]
+		}
+
+		trimmed[N] = vals
+	}
+
+	return trimmed
+}
+
+// ClassifyWithConfidence classifies the data as Classify does and also
+// returns the margin between the best score and the next best score of any
+// other BigO. A large margin means the classification is decisive, while a
+// margin near 0 means the data is ambiguous between the top classes (e.g.,
+// O(n) at 0.991 and O(n log n) at 0.989) and the N values likely need to
+// span a wider range. The margin is never negative.
+//
+// Errors are the same as Classify, including when there are fewer than
+// three distinct N values.
+func (o *Classifier) ClassifyWithConfidence() (*Rating, float64, error) {
+	rating, err := o.Classify()
+	if errors.Is(err, ErrInsufficientData) {
+		return rating, 0, err
+	}
+
+	margin := 0.0
+	for _, r := range o.ratingsByScore() {
+		if r.bigO != rating.bigO {
+			margin = max(0, rating.score-r.score)
+
+			break
+		}
+	}
+
+	return rating, margin, err
+}
+
+// AggregationMode selects how Classify reduces the values recorded at each N
+// before rating them.
+type AggregationMode int
+
+const (
+	// AggregationMean averages the values at each N. This is the default.
+	AggregationMean AggregationMode = iota
+	// AggregationRaw rates every value as a separate observation at its N,
+	// so an N with more values carries more weight in the correlation.
+	AggregationRaw
+	// AggregationMedian takes the median of the values at each N, which
+	// ignores a few wild values.
+	AggregationMedian
+	// AggregationMin takes the smallest value at each N. For timing data
+	// the fastest run is usually the least contaminated by noise.
+	AggregationMin
+)
+
+// String returns the name of the aggregation mode.
+func (m AggregationMode) String() string {
+	switch m {
+	case AggregationMean:
+		return "Mean"
+	case AggregationRaw:
+		return "Raw"
+	case AggregationMedian:
+		return "Median"
+	case AggregationMin:
+		return "Min"
+	default:
+		return fmt.Sprintf("AggregationMode(%d)", int(m))
+	}
+}
+
+// SetAggregation sets how Classify reduces the float64 values at each N
+// before rating them. The default is AggregationMean. Data containing
+// big.Float values can only be averaged, so Classify returns an error
+// wrapping ErrBigFloatUnsupported if another mode is set for it. The other
+// analyses (such as IsMonotonic or EstimateExponent) always use the mean.
+func (o *Classifier) SetAggregation(mode AggregationMode) {
+	o.aggregation = mode
+}
+
+// aggregatedValues returns the N values and the values at each reduced as
+// set by SetAggregation, in increasing N, less the baseline's average at
+// that N if a baseline is set. For AggregationRaw, each N is repeated once
+// for each of its values.
+func (o *Classifier) aggregatedValues() ([]int, []float64) {
+	if o.aggregation == AggregationMean {
+		return o.meanValues()
+	}
+
+	data := o.samples()
+	Ns := slices.Sorted(maps.Keys(data))
+
+	var outNs []int
+	var vals []float64
+	for _, N := range Ns {
+		if len(data[N]) == 0 {
+			continue
+		}
+
+		switch o.aggregation {
+		case AggregationRaw:
+			for _, v := range data[N] {
+				outNs = append(outNs, N)
+				vals = append(vals, v)
+			}
+		case AggregationMedian:
+			sorted := slices.Sorted(slices.Values(data[N]))
+			outNs = append(outNs, N)
+			vals = append(vals, percentile(sorted, 0.5))
+		case AggregationMin:
+			outNs = append(outNs, N)
+			vals = append(vals, slices.Min(data[N]))
+		default:
+			outNs = append(outNs, N)
+			vals = append(vals, mean(data[N]))
+		}
+	}
+
+	o.subtractBaseline(outNs, vals)
+
+	return outNs, vals
+}
+
+// meanValues returns the distinct N values in sorted order along with the
+// average of all the values recorded for each N, less the baseline's average
+// at that N if a baseline is set.
+func (o *Classifier) meanValues() ([]int, []float64) {
+	Ns, vals := meansByN(o.samples())
+	o.subtractBaseline(Ns, vals)
+
+	return Ns, vals
+}
+
+// subtractBaseline subtracts the baseline's mean value from vals in place at
+// each N that the baseline also has. It does nothing if no baseline is set.
+func (o *Classifier) subtractBaseline(Ns []int, vals []float64) {
+	if o.baseline == nil || o.baseline == o {
+		return
+	}
+
+	baseNs, baseVals := meansByN(o.baseline.samples())
+	baseMeans := make(map[int]float64, len(baseNs))
+	for i, N := range baseNs {
+		baseMeans[N] = baseVals[i]
+	}
+
+	for i, N := range Ns {
+		if base, ok := baseMeans[N]; ok {
+			vals[i] -= base
+		}
+	}
+}
+
+// numDistinctNs returns the number of distinct N values across both the
+// float64 and big.Float data.
+func (o *Classifier) numDistinctNs() int {
+	count := len(o.data)
+	for N := range o.dataBig {
+		if _, ok := o.data[N]; !ok {
+			count++
+		}
+	}
+
+	return count
+}
+
+// numDistinct returns the number of distinct values in the sorted Ns.
+func numDistinct(Ns []int) int {
+	count := 0
+	for i, N := range Ns {
+		if i == 0 || N != Ns[i-1] {
+			count++
+		}
+	}
+
+	return count
+}
+
+// meanValuesBig returns the distinct N values across both the float64 and
+// big.Float data in sorted order along with the big.Float average of all the
+// values recorded for each N. The float64 values are promoted to big.Float so
+// that mixed data can be rated together. The baseline's average is
+// subtracted at each N if a baseline is set.
+func (o *Classifier) meanValuesBig() ([]int, []*big.Float) {
+	var Ns []int
+	for N := range o.data {
+		Ns = append(Ns, N)
+	}
+
+	for N := range o.dataBig {
+		if _, ok := o.data[N]; !ok {
+			Ns = append(Ns, N)
+		}
+	}
+
+	sort.Ints(Ns)
+
+	var baseMeans map[int]float64
+	if o.baseline != nil && o.baseline != o {
+		baseNs, baseVals := meansByN(o.baseline.samples())
+		baseMeans = make(map[int]float64, len(baseNs))
+		for i, N := range baseNs {
+			baseMeans[N] = baseVals[i]
+		}
+	}
+
+	data, dataBig := o.samples(), o.samplesBig()
+
+	var outNs []int
+	var vals []*big.Float
+	for _, N := range Ns {
+		// N values added without any values have nothing to average.
+		count := len(data[N]) + len(dataBig[N])
+		if count == 0 {
+			continue
+		}
+
+		sum := new(big.Float)
+		for _, v := range data[N] {
+			sum.Add(sum, big.NewFloat(v))
+		}
+
+		for _, v := range dataBig[N] {
+			sum.Add(sum, v)
+		}
+
+		val := sum.Quo(sum, big.NewFloat(float64(count)))
+
+		if base, ok := baseMeans[N]; ok {
+			val.Sub(val, big.NewFloat(base))
+		}
+
+		outNs = append(outNs, N)
+		vals = append(vals, val)
+	}
+
+	return outNs, vals
+}
+
+// meansByN returns the distinct N values in the given data in increasing
+// order along with the mean of the values recorded at each N. N values with
+// no recorded values are left out.
+func meansByN(data map[int][]float64) ([]int, []float64) {
+	var Ns []int
+	// First pass through, pull out the distinct N values that have values
+	// to average and order them.
+	for N, vals := range data {
+		if len(vals) > 0 {
+			Ns = append(Ns, N)
+		}
+	}
+
+	sort.Ints(Ns)
+
+	// Second pass through, compute the average result value for the given N
+	// and store it.
+	vals := make([]float64, len(Ns))
+	for i, N := range Ns {
+		val := 0.0
+		for _, v := range data[N] {
+			val += v
+		}
+
+		vals[i] = val / float64(len(data[N]))
+	}
+
+	return Ns, vals
+}
+
+// SetBaseline sets a reference Classifier whose measurements capture fixed
+// overhead such as the benchmark harness itself. At each N present in both,
+// the baseline's mean value is subtracted from this Classifier's mean value
+// before fitting, which isolates the algorithm's intrinsic scaling. N values
+// missing from the baseline are left unchanged. Passing nil clears the
+// baseline.
+//
+// Only the baseline's own data is used; a baseline set on the baseline is
+// not applied.
+func (o *Classifier) SetBaseline(baseline *Classifier) {
+	o.baseline = baseline
+}
+
+// PairwiseGrowthRatios returns, for each consecutive pair of sorted N values,
+// the ratio of the larger N to the smaller N (nRatios) and the ratio of their
+// mean values (valRatios). This is a lightweight diagnostic to eyeball before
+// trusting a fit. e.g., if N roughly doubles and the value roughly quadruples,
+// the data is likely O(n^2).
+//
+// At least two distinct N values are required. If a mean value is zero, the
+// corresponding value ratio will be +Inf or NaN.
+func (o *Classifier) PairwiseGrowthRatios() ([]float64, []float64, error) {
+	if len(o.dataBig) > 0 {
+		return nil, nil, fmt.Errorf("%w: growth ratios are only computed for float64 data", ErrBigFloatUnsupported)
+	}
+
+	Ns, vals := o.meanValues()
+	if len(Ns) < 2 {
+		return nil, nil, fmt.Errorf("%w: not enough data points (%d) to compute growth ratios", ErrInsufficientData, len(Ns))
+	}
+
+	nRatios := make([]float64, len(Ns)-1)
+	valRatios := make([]float64, len(Ns)-1)
+	for i := 1; i < len(Ns); i++ {
+		nRatios[i-1] = float64(Ns[i]) / float64(Ns[i-1])
+		valRatios[i-1] = vals[i] / vals[i-1]
+	}
+
+	return nRatios, valRatios, nil
+}
+
+// ObservedExponentPerDecade fits a line through the points (log10 N,
+// log10 value) using the mean value at each N and returns its slope. For data
+// that grows like n^k the slope estimates k directly, e.g., 1 for linear and
+// 2 for quadratic, regardless of any constant factor. Slower than polynomial
+// growth gives slopes near 0, and faster than polynomial growth gives large
+// slopes that keep rising as larger N are added.
+//
+// All mean values must be positive, and at least two distinct N are needed.
+func (o *Classifier) ObservedExponentPerDecade() (float64, error) {
+	if len(o.dataBig) > 0 {
+		return 0, fmt.Errorf("%w: the exponent is only computed for float64 data", ErrBigFloatUnsupported)
+	}
+
+	Ns, vals := o.meanValues()
+	if len(Ns) < 2 {
+		return 0, fmt.Errorf("%w: not enough data points (%d) to fit an exponent", ErrInsufficientData, len(Ns))
+	}
+
+	xs := make([]float64, len(Ns))
+	ys := make([]float64, len(Ns))
+	for i, N := range Ns {
+		if vals[i] <= 0 {
+			return 0, fmt.Errorf("mean value %v at N=%d must be positive to take its log", vals[i], N)
+		}
+
+		xs[i] = math.Log10(float64(N))
+		ys[i] = math.Log10(vals[i])
+	}
+
+	_, slope, _ := leastSquares(xs, ys)
+
+	return slope, nil
+}
+
+// EstimateExponent estimates the degree k of the best-fit polynomial n^k by a
+// log-log linear regression on the mean value at each N: the slope of
+// log(value) against log(N) is k, e.g., about 1.98 for quadratic data with
+// some noise. This corroborates a Quadratic or Cubic classification, or
+// gives a degree to pass to NewPolynomialBigO.
+//
+// Unlike ObservedExponentPerDecade, N values whose mean is not positive are
+// skipped rather than treated as an error, since their log is undefined. At
+// least three usable N values are needed.
+func (o *Classifier) EstimateExponent() (float64, error) {
+	if len(o.dataBig) > 0 {
+		return 0, fmt.Errorf("%w: the exponent is only estimated for float64 data", ErrBigFloatUnsupported)
+	}
+
+	Ns, vals := o.meanValues()
+
+	var xs, ys []float64
+	for i, N := range Ns {
+		if vals[i] <= 0 {
+			continue
+		}
+
+		xs = append(xs, math.Log(float64(N)))
+		ys = append(ys, math.Log(vals[i]))
+	}
+
+	if len(xs) < 3 {
+		return 0, fmt.Errorf("%w: not enough positive data points (%d) to estimate an exponent", ErrInsufficientData, len(xs))
+	}
+
+	_, slope, _ := leastSquares(xs, ys)
+
+	return slope, nil
+}
+
+// The log-log slopes bounding the "linear" GrowthCategory.
+const (
+	linearGrowthMinSlope = 0.9
+	linearGrowthMaxSlope = 1.1
+)
+
+// GrowthCategory is a cheap triage of the data that reports whether it grows
+// "sublinear", "linear", or "superlinear" from the log-log regression slope
+// of EstimateExponent (below 0.9, 0.9 to 1.1, and above 1.1 respectively),
+// without rating every BigO as Classify does. It can help decide whether a
+// deeper analysis is warranted.
+//
+// The requirements and errors are the same as EstimateExponent.
+func (o *Classifier) GrowthCategory() (string, error) {
+	slope, err := o.EstimateExponent()
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case slope < linearGrowthMinSlope:
+		return "sublinear", nil
+	case slope > linearGrowthMaxSlope:
+		return "superlinear", nil
+	default:
+		return "linear", nil
+	}
+}
+
+// VarianceReport returns the coefficient of variation (stddev/mean) of the
+// measurements at each N that has more than one value. N values with a single
+// measurement are omitted since they have no spread to report.
+//
+// A high CV at a given N means the repeated runs disagree with each other and
+// the mean used for classification at that N is unreliable.
+func (o *Classifier) VarianceReport() map[int]float64 {
+	report := make(map[int]float64)
+	for N, vals := range o.samples() {
+		if len(vals) < 2 {
+			continue
+		}
+
+		report[N] = coefficientOfVariation(vals)
+	}
+
+	return report
+}
+
+// MedianCV returns the median of the coefficients of variation in the
+// VarianceReport. A high median CV is a warning that the benchmarks are too
+// noisy for a reliable classification. If no N has multiple measurements,
+// NaN is returned.
+func (o *Classifier) MedianCV() float64 {
+	report := o.VarianceReport()
+	if len(report) == 0 {
+		return math.NaN()
+	}
+
+	cvs := make([]float64, 0, len(report))
+	for _, cv := range report {
+		cvs = append(cvs, cv)
+	}
+
+	sort.Float64s(cvs)
+
+	mid := len(cvs) / 2
+	if len(cvs)%2 == 0 {
+		return (cvs[mid-1] + cvs[mid]) / 2
+	}
+
+	return cvs[mid]
+}
+
+// bootstrapSeed seeds the resampling in CoefficientCI so that repeated calls
+// on the same data produce the same interval.
+const bootstrapSeed = 0x5eed
+
+// CoefficientCI returns a 95% percentile bootstrap confidence interval on the
+// leading coefficient of the winning BigO. The coefficient is the c in
+// value ≈ a + c*f(n), e.g., the nanoseconds per element for O(n) timings.
+//
+// Each of the given number of iterations resamples the individual data
+// points with replacement, recomputes the mean value at each N, and refits
+// the coefficient. The 2.5th and 97.5th percentiles of the refitted
+// coefficients are returned. A narrow interval means the measurements pin
+// down the constant factor well, which matters when comparing two
+// implementations of the same complexity.
+//
+// Classify must have been called first.
+func (o *Classifier) CoefficientCI(iterations int) (float64, float64, error) {
+	if !o.classified {
+		return 0, 0, fmt.Errorf("%w: Classify must be called first", ErrNotClassified)
+	}
+
+	if iterations < 1 {
+		return 0, 0, fmt.Errorf("iterations must be positive, got %d", iterations)
+	}
+
+	if len(o.dataBig) > 0 {
+		return 0, 0, fmt.Errorf("%w: the bootstrap only resamples float64 data", ErrBigFloatUnsupported)
+	}
+
+	winner := o.rating.bigO
+	if winner == Unrated {
+		return 0, 0, fmt.Errorf("no BigO was rated for this data")
+	}
+
+	// Flatten the data into individual observations to resample from,
+	// in N order so the seeded resampling is reproducible.
+	Ns := make([]int, 0, len(o.data))
+	for N := range o.data {
+		Ns = append(Ns, N)
+	}
+
+	sort.Ints(Ns)
+
+	data := o.samples()
+
+	var pointNs []int
+	var pointVals []float64
+	for _, N := range Ns {
+		for _, v := range data[N] {
+			pointNs = append(pointNs, N)
+			pointVals = append(pointVals, v)
+		}
+	}
+
+	rng := rand.New(rand.NewPCG(bootstrapSeed, uint64(len(pointNs))))
+
+	coefficients := make([]float64, 0, iterations)
+	for range iterations {
+		sample := make(map[int][]float64)
+		for range pointNs {
+			j := rng.IntN(len(pointNs))
+			sample[pointNs[j]] = append(sample[pointNs[j]], pointVals[j])
+		}
+
+		// A fit needs at least two distinct N values.
+		if len(sample) < 2 {
+			continue
+		}
+
+		sampleNs, sampleVals := meansByN(sample)
+		o.subtractBaseline(sampleNs, sampleVals)
+		_, c := winner.fitCoefficient(sampleNs, sampleVals)
+		coefficients = append(coefficients, c)
+	}
+
+	if len(coefficients) == 0 {
+		return 0, 0, fmt.Errorf("%w: not enough distinct data points to bootstrap", ErrInsufficientData)
+	}
+
+	sort.Float64s(coefficients)
+
+	return percentile(coefficients, 0.025), percentile(coefficients, 0.975), nil
+}
+
+// WinnerModelFunc returns a function t(n) = c·f(n), where f is the model
+// function of the winning BigO and c is its least squares coefficient fitted
+// through the origin to the mean value at each N. The returned function can
+// be used to predict values at other N or to plot the fitted curve against
+// the measurements. Values of n below the model's valid input range return 0
+// and values above it return +Inf.
+//
+// Classify must have been called first.
+func (o *Classifier) WinnerModelFunc() (func(n float64) float64, error) {
+	if !o.classified {
+		return nil, fmt.Errorf("%w: Classify must be called first", ErrNotClassified)
+	}
+
+	if len(o.dataBig) > 0 {
+		return nil, fmt.Errorf("%w: the model is only fitted to float64 data", ErrBigFloatUnsupported)
+	}
+
+	winner := o.rating.bigO
+	if winner == Unrated {
+		return nil, fmt.Errorf("no BigO was rated for this data")
+	}
+
+	// Least squares through the origin: c = Σ f(n)·v / Σ f(n)².
+	Ns, vals := o.meanValues()
+	coefficient, ok := winner.scaleCoefficient(Ns, vals)
+	if !ok {
+		return nil, fmt.Errorf("the %s model is zero at every N", winner.label)
+	}
+
+	return func(n float64) float64 {
+		switch {
+		case n < winner.floatCutoffMin:
+			return 0
+		case n <= winner.floatCutoffMax:
+			return coefficient * winner.funcFloatFloat(n)
+		default:
+			return math.Inf(1)
+		}
+	}, nil
+}
+
+// WinnerMAPE returns the mean absolute percentage error between the mean
+// value at each N and the prediction of the fitted winning model from
+// WinnerModelFunc, as a percentage (e.g., 4 means the model is within 4% on
+// average). Unlike the correlation score, this reflects how closely the
+// fitted curve tracks the actual values: data with a large constant offset
+// can correlate perfectly with its BigO and still have a large error. N
+// values whose mean is zero are skipped since their percentage error is
+// undefined.
+//
+// Classify must have been called first.
+func (o *Classifier) WinnerMAPE() (float64, error) {
+	model, err := o.WinnerModelFunc()
+	if err != nil {
+		return 0, err
+	}
+
+	Ns, vals := o.meanValues()
+
+	sum := 0.0
+	count := 0
+	for i, N := range Ns {
+		if vals[i] == 0 {
+			continue
+		}
+
+		sum += math.Abs((vals[i] - model(float64(N))) / vals[i])
+		count++
+	}
+
+	if count == 0 {
+		return 0, fmt.Errorf("%w: every mean value is zero", ErrInsufficientData)
+	}
+
+	return 100 * sum / float64(count), nil
+}
+
+// percentile returns the p-th percentile (0 <= p <= 1) of the sorted values
+// using linear interpolation between the closest ranks.
+func percentile(sorted []float64, p float64) float64 {
+	pos := p * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	frac := pos - float64(lower)
+
+	return sorted[lower] + frac*(sorted[upper]-sorted[lower])
+}
+
+// ratingsByScore returns a copy of the current ratings sorted by descending
+// score. Ties keep their rank order.
+func (o *Classifier) ratingsByScore() []*Rating {
+	sorted := make([]*Rating, len(o.ratings))
+	copy(sorted, o.ratings)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].score > sorted[j].score
+	})
+
+	return sorted
+}
+
+// flatAmbiguityThreshold is how close (in score) a flat BigO must be to the
+// best score to be considered a contender by DisambiguateFlat.
+const flatAmbiguityThreshold = 0.02
+
+// flatMonotonicFraction is the fraction of consecutive steps between sorted
+// N values that must increase for the data to be considered growing rather
+// than constant with noise.
+const flatMonotonicFraction = 0.75
+
+// isFlatBigO reports whether the given BigO is one of the very flat curves
+// that are hard to tell apart over a narrow range of N.
+func isFlatBigO(b *BigO) bool {
+	return b == Constant || b == InverseAckerman || b == LogLog || b == Log
+}
+
+// DisambiguateFlat attempts to separate the very flat classes (O(1), O(α(n)),
+// O(log log n), and O(log n)) when they score nearly identically. These are
+// notoriously hard to tell apart because a constant overhead in the timings
+// can make a slowly growing curve look constant, and within a narrow range of
+// N the growing curves are nearly straight lines.
+//
+// If the best rating is not a flat class, it is returned unchanged. Otherwise
+// every flat class whose score is within a small threshold of the best score
+// is considered a contender and a targeted test is applied:
+//
+//   - If the mean values do not consistently increase across the sorted N
+//     values, the growth is attributed to noise and O(1) wins.
+//   - Otherwise the observed growth at each N, as a fraction of the total
+//     growth across the widest N gap, is compared to the fraction each
+//     growing model predicts. The model with the closest shape wins.
+//
+// The returned string explains the decision. Classify must have been called,
+// and only float64 data is supported.
+func (o *Classifier) DisambiguateFlat() (*BigO, string, error) {
+	if !o.classified {
+		return defaultBigO, "", fmt.Errorf("%w: Classify must be called first", ErrNotClassified)
+	}
+
+	if len(o.dataBig) > 0 {
+		return defaultBigO, "", fmt.Errorf("%w: the flat class tests are only computed for float64 data", ErrBigFloatUnsupported)
+	}
+
+	ranked := o.ratingsByScore()
+	best := ranked[0]
+	if !isFlatBigO(best.bigO) {
+		return best.bigO, fmt.Sprintf("best fit %s is not a flat class, no disambiguation needed", best.bigO.label), nil
+	}
+
+	var contenders []*BigO
+	for _, r := range ranked {
+		if isFlatBigO(r.bigO) && best.score-r.score <= flatAmbiguityThreshold {
+			contenders = append(contenders, r.bigO)
+		}
+	}
+
+	if len(contenders) == 1 {
+		return best.bigO, fmt.Sprintf("%s is the only flat class within %0.2f of the best score", best.bigO.label, flatAmbiguityThreshold), nil
+	}
+
+	Ns, vals := o.meanValues()
+	first, last := 0, len(Ns)-1
+	totalGrowth := vals[last] - vals[first]
+
+	// Check whether the values are genuinely growing or just noisy.
+	increasing := 0
+	for i := 1; i < len(vals); i++ {
+		if vals[i] > vals[i-1] {
+			increasing++
+		}
+	}
+
+	growing := totalGrowth > 0 && float64(increasing)/float64(len(vals)-1) >= flatMonotonicFraction
+	if !growing {
+		if slices.Contains(contenders, Constant) {
+			return Constant, fmt.Sprintf("values increased in only %d of %d steps from N=%d to N=%d, treating the growth as noise",
+				increasing, len(vals)-1, Ns[first], Ns[last]), nil
+		}
+
+		return best.bigO, fmt.Sprintf("values do not grow consistently from N=%d to N=%d, keeping the best score %s",
+			Ns[first], Ns[last], best.bigO.label), nil
+	}
+
+	// Compare the shape of the observed growth to each growing model.
+	bestErr := math.Inf(1)
+	winner := best.bigO
+	for _, b := range contenders {
+		if b == Constant {
+			continue
+		}
+
+		fFirst := b.predictFloat(Ns[first])
+		fLast := b.predictFloat(Ns[last])
+		predictedGrowth := fLast - fFirst
+		if predictedGrowth <= 0 || math.IsInf(predictedGrowth, 0) {
+			continue
+		}
+
+		shapeErr := 0.0
+		for i, n := range Ns {
+			observed := (vals[i] - vals[first]) / totalGrowth
+			predicted := (b.predictFloat(n) - fFirst) / predictedGrowth
+			shapeErr += (observed - predicted) * (observed - predicted)
+		}
+
+		if shapeErr < bestErr {
+			bestErr = shapeErr
+			winner = b
+		}
+	}
+
+	return winner, fmt.Sprintf("values grew by %0.4g from N=%d to N=%d in %d of %d steps, and the shape best matches %s",
+		totalGrowth, Ns[first], Ns[last], increasing, len(vals)-1, winner.label), nil
+}
+
+// explosiveSlopeThreshold separates factorial from exponential growth in
+// DisambiguateExplosive. The per-unit growth rate of n! rises like log n (a
+// slope of 1 against log n) while that of k^n is constant (a slope of 0).
+const explosiveSlopeThreshold = 0.5
+
+// isExplosiveBigO reports whether the given BigO is one of the explosive
+// curves that are only measurable over a small range of N.
+func isExplosiveBigO(b *BigO) bool {
+	return b == Exponential || b == Factorial || b == HyperExponential
+}
+
+// DisambiguateExplosive attempts to separate O(2^n) from O(n!) when the best
+// rating is one of the explosive classes. These can only be measured over a
+// tiny range of N (often n ≤ 20), over which their correlation scores are
+// nearly identical.
+//
+// The targeted test is the ratio f(n+1)/f(n), which is a constant (2) for
+// exponential growth but n+1 for factorial growth. Since the measured N may
+// not be consecutive, the growth rate per unit of N between neighboring
+// points, log(v(b)/v(a))/(b-a), is computed and a line is fit of it against
+// log of the midpoint N. A slope near 0 means constant ratios (exponential)
+// and a slope near 1 means ratios growing with n (factorial). This is
+// unaffected by any constant factor in the values, and it holds for any base
+// of the exponential, not just 2.
+//
+// If the best rating is not an explosive class, it is returned unchanged.
+// The returned string explains the decision. Classify must have been called.
+func (o *Classifier) DisambiguateExplosive() (*BigO, string, error) {
+	if !o.classified {
+		return defaultBigO, "", fmt.Errorf("%w: Classify must be called first", ErrNotClassified)
+	}
+
+	best := o.rating.bigO
+	if !isExplosiveBigO(best) {
+		return best, fmt.Sprintf("best fit %s is not an explosive class, no disambiguation needed", best.label), nil
+	}
+
+	if len(o.dataBig) > 0 {
+		return defaultBigO, "", fmt.Errorf("%w: the ratio test is only computed for float64 data", ErrBigFloatUnsupported)
+	}
+
+	Ns, vals := o.meanValues()
+
+	xs := make([]float64, 0, len(Ns)-1)
+	rates := make([]float64, 0, len(Ns)-1)
+	for i := 1; i < len(Ns); i++ {
+		if vals[i-1] <= 0 || vals[i] <= 0 {
+			return defaultBigO, "", fmt.Errorf("values must be positive for the ratio test, got %v at N=%d and %v at N=%d",
+				vals[i-1], Ns[i-1], vals[i], Ns[i])
+		}
+
+		mid := float64(Ns[i-1]+Ns[i]) / 2
+		xs = append(xs, math.Log(mid))
+		rates = append(rates, math.Log(vals[i]/vals[i-1])/float64(Ns[i]-Ns[i-1]))
+	}
+
+	_, slope, _ := leastSquares(xs, rates)
+	meanRate := mean(rates)
+
+	winner := Exponential
+	if slope >= explosiveSlopeThreshold {
+		winner = Factorial
+	}
+
+	return winner, fmt.Sprintf("growth ratio per unit of N averaged %0.3f from N=%d to N=%d; its slope against log n is %0.3f, which matches %s",
+		math.Exp(meanRate), Ns[0], Ns[len(Ns)-1], slope, winner.label), nil
+}
+
+// effectiveTailMinR2 is how well a faster growing BigO must explain the
+// slopes of the tail of the data before EffectiveComplexity will report it
+// as the asymptotic class.
+const effectiveTailMinR2 = 0.9
+
+// effectiveTailMinPoints is the fewest distinct N needed in the tail of the
+// data for EffectiveComplexity to extrapolate from it.
+const effectiveTailMinPoints = 5
+
+// EffectiveComplexity separates the complexity the data behaves as in the
+// tested range from what its tail suggests it will become. Lower order terms
+// with large constants (e.g., n² + 1000·n) can make the best fit a simpler
+// BigO than the true asymptotic one when N is small.
+//
+// effectiveInRange is the best rating from Classify. The asymptotic class is
+// found from the slopes Δv/ΔN between neighboring points in the later half
+// of the data. A lower order term contributes at most a constant to those
+// slopes, so each BigO at or above the effective one is fit as
+// slope ≈ a + b·Δf/ΔN, and the simplest one that explains the tail slopes
+// well with a growing b is chosen. If there are too few points to judge the
+// tail, or nothing fits it better, both results are the same.
+//
+// Classify must have been called, and only float64 data is supported.
+func (o *Classifier) EffectiveComplexity() (*BigO, *BigO, error) {
+	if !o.classified {
+		return defaultBigO, defaultBigO, fmt.Errorf("%w: Classify must be called first", ErrNotClassified)
+	}
+
+	if len(o.dataBig) > 0 {
+		return defaultBigO, defaultBigO, fmt.Errorf("%w: tail slopes are only computed for float64 data", ErrBigFloatUnsupported)
+	}
+
+	effective := o.rating.bigO
+
+	Ns, vals := o.meanValues()
+	tail := max(len(Ns)/2, effectiveTailMinPoints)
+	if len(Ns) < tail {
+		return effective, effective, nil
+	}
+
+	Ns, vals = Ns[len(Ns)-tail:], vals[len(vals)-tail:]
+
+	slopes := make([]float64, 0, tail-1)
+	for i := 1; i < tail; i++ {
+		slopes = append(slopes, (vals[i]-vals[i-1])/float64(Ns[i]-Ns[i-1]))
+	}
+
+	meanSlope := mean(slopes)
+	totalSS := 0.0
+	for _, s := range slopes {
+		totalSS += (s - meanSlope) * (s - meanSlope)
+	}
+
+	// Constant slopes leave nothing to extrapolate from.
+	if totalSS == 0 {
+		return effective, effective, nil
+	}
+
+	asymptotic := effective
+	bestR2 := 0.0
+	for _, b := range o.candidates() {
+		if b.rank < effective.rank || !b.active || Ns[len(Ns)-1] > b.scalingCutoff {
+			continue
+		}
+
+		modelSlopes := make([]float64, 0, tail-1)
+		for i := 1; i < tail; i++ {
+			modelSlopes = append(modelSlopes, (b.predictFloat(Ns[i])-b.predictFloat(Ns[i-1]))/float64(Ns[i]-Ns[i-1]))
+		}
+
+		intercept, coefficient, ok := leastSquares(modelSlopes, slopes)
+		if !ok || coefficient <= 0 || math.IsNaN(coefficient) || math.IsInf(coefficient, 0) {
+			continue
+		}
+
+		residualSS := 0.0
+		for i, s := range slopes {
+			r := s - (intercept + coefficient*modelSlopes[i])
+			residualSS += r * r
+		}
+
+		// Ties keep the simpler BigO, as in Classify.
+		if r2 := 1 - residualSS/totalSS; r2 >= effectiveTailMinR2 && r2 > bestR2+scoreTieTolerance {
+			asymptotic = b
+			bestR2 = r2
+		}
+	}
+
+	return asymptotic, effective, nil
+}
+
+// suggestAmbiguityThreshold is how close (in score) another BigO must be to
+// the best score for SuggestNextN to try to separate the two.
+const suggestAmbiguityThreshold = 0.01
+
+// suggestSeparationRatio is how much further apart, as a ratio, two
+// contending models' predictions must grow beyond the current maximum N
+// before SuggestNextN considers a new N large enough to tell them apart.
+const suggestSeparationRatio = 2.0
+
+// suggestMaxFactor caps how far beyond the current maximum N SuggestNextN
+// will look.
+const suggestMaxFactor = 1 << 20
+
+// SuggestNextN recommends the next input size to measure to make the
+// classification more decisive. Within a narrow span of N, similar curves
+// such as O(n) and O(n log n) score almost identically, and the only remedy
+// is to measure at larger N where they diverge.
+//
+// Every BigO scoring within suggestAmbiguityThreshold of the best is a
+// contender. For each, the maximum N is doubled until the ratio of the two
+// models' predictions has changed by suggestSeparationRatio compared to the
+// current maximum N, and the largest such N is returned. Contenders that
+// would not separate within suggestMaxFactor times the current maximum
+// (e.g., O(n) and O(n log* n)) can't practically be told apart and are
+// ignored. With no separable contenders, the suggestion is simply double the
+// current maximum N to keep extending the span.
+//
+// Classify must have been called first.
+func (o *Classifier) SuggestNextN() (int, error) {
+	if !o.classified {
+		return 0, fmt.Errorf("%w: Classify must be called first", ErrNotClassified)
+	}
+
+	maxN := 0
+	for N := range o.data {
+		maxN = max(maxN, N)
+	}
+
+	for N := range o.dataBig {
+		maxN = max(maxN, N)
+	}
+
+	best := o.rating
+	suggestion := 2 * maxN
+
+	for _, r := range o.ratingsByScore() {
+		if r.bigO == best.bigO || best.score-r.score > suggestAmbiguityThreshold {
+			continue
+		}
+
+		if n, ok := separatingN(best.bigO, r.bigO, maxN); ok {
+			suggestion = max(suggestion, n)
+		}
+	}
+
+	return suggestion, nil
+}
+
+// separatingN returns the smallest N, doubling from maxN, at which the ratio
+// of the predictions of a and b differs from their ratio at maxN by at least
+// suggestSeparationRatio. It returns false if no such N is found within
+// suggestMaxFactor times maxN.
+func separatingN(a, b *BigO, maxN int) (int, bool) {
+	ratioAt := func(n int) float64 {
+		return a.predictFloat(n) / b.predictFloat(n)
+	}
+
+	base := ratioAt(maxN)
+	if base <= 0 || math.IsNaN(base) || math.IsInf(base, 0) {
+		return 0, false
+	}
+
+	for factor := 2; factor <= suggestMaxFactor; factor *= 2 {
+		n := maxN * factor
+		change := ratioAt(n) / base
+		if math.IsNaN(change) {
+			// Both models are past their computable range.
+			return 0, false
+		}
+
+		if change < 1 {
+			change = 1 / change
+		}
+
+		// This includes one model blowing past the other to +Inf, which
+		// separates them decisively.
+		if change >= suggestSeparationRatio {
+			return n, true
+		}
+	}
+
+	return 0, false
 }
 
 // GetAllRatings returns a copy of all the ratings generated by the most recent Classify() call.
@@ -362,7 +2217,80 @@ func (o *Classifier) GetAllRatings() []*Rating {
 	return ratingsCopy
 }
 
+// TopRatings returns up to n ratings from the most recent Classify() call,
+// sorted by descending score so the most likely BigO comes first. If n
+// exceeds the number of ratings, all of them are returned. Returns nil if
+// Classify() has not been called yet.
+//
+// This is useful for presenting the closest alternatives when several BigO
+// score nearly the same. The returned slice is a copy and can be safely
+// modified without affecting internal state.
+func (o *Classifier) TopRatings(n int) []*Rating {
+	if !o.classified {
+		return nil
+	}
+
+	sorted := o.ratingsByScore()
+
+	return sorted[:max(0, min(n, len(sorted)))]
+}
+
+// ScoreMap returns the score of each BigO rated by the most recent Classify()
+// call, keyed by its label (e.g., "O(n)"), for rendering results without
+// parsing the Summary() text. BigOs that failed to be rated are left out.
+// Returns nil if Classify() has not been called yet.
+func (o *Classifier) ScoreMap() map[string]float64 {
+	if !o.classified {
+		return nil
+	}
+
+	scores := make(map[string]float64, len(o.ratings))
+	for _, r := range o.ratings {
+		if r.bigO == Unrated {
+			continue
+		}
+
+		scores[r.bigO.label] = r.score
+	}
+
+	return scores
+}
+
+// WriteReportCSV writes the ratings from the most recent Classify() call to w
+// as CSV, with a header row of "label,rank,score" and then one row per rating
+// in the same order as GetAllRatings(). Scores are written at full precision
+// so reports can be archived and compared across code versions.
+//
+// Classify must have been called first.
+func (o *Classifier) WriteReportCSV(w io.Writer) error {
+	if !o.classified {
+		return fmt.Errorf("%w: Classify must be called first", ErrNotClassified)
+	}
+
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write([]string{"label", "rank", "score"}); err != nil {
+		return err
+	}
+
+	for _, r := range o.GetAllRatings() {
+		row := []string{
+			r.bigO.label,
+			strconv.Itoa(r.bigO.rank),
+			strconv.FormatFloat(r.score, 'g', -1, 64),
+		}
+
+		if err := csvWriter.Write(row); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+
+	return csvWriter.Error()
+}
+
 // Summary returns a longer form view of the results as a formatted text blob.
+// Each rated BigO is listed with its correlation score and its R².
 func (o *Classifier) Summary() string {
 	if !o.classified {
 		return "Not Classified yet"
@@ -370,6 +2298,15 @@ func (o *Classifier) Summary() string {
 
 	var buf bytes.Buffer
 	fmt.Fprintf(&buf, "\nBigO:  %s\n", o.rating.bigO.String())
+	fmt.Fprintf(&buf, "%s\n", o.description(o.rating.bigO))
+	if asymptotic, effective, err := o.EffectiveComplexity(); err == nil && asymptotic != effective {
+		fmt.Fprintf(&buf, "behaves as %s in tested range; tail suggests %s\n", effective.label, asymptotic.label)
+	}
+
+	if o.rating.nonMonotonic {
+		fmt.Fprintf(&buf, "warning: mean values do not increase with N, so this fit is questionable\n")
+	}
+
 	fmt.Fprintf(&buf, "Num data points: %d\n", len(o.data))
 
 	// TODO(rsned): Add min/max values for N and Vals to the output.
@@ -383,7 +2320,56 @@ func (o *Classifier) Summary() string {
 			addedText = winner
 		}
 
-		fmt.Fprintf(&buf, "%15s:   %0.8f%s\n", r.bigO.label, r.score, addedText)
+		fmt.Fprintf(&buf, "%15s:   %0.8f   R²: %0.8f%s\n", r.bigO.label, r.score, r.RSquared(), addedText)
+	}
+
+	return buf.String()
+}
+
+// DetailedSummary returns Summary followed by, for each rated BigO, the value
+// its model predicts at each N next to the actual value that was rated. The
+// prediction is the least squares fit a + b·f(n) of the BigO's model f to the
+// data, which is the line its correlation score measures, so it shows where
+// a fit diverges (e.g., only at the largest N). This is useful for debugging
+// a surprising classification or judging whether more data is needed.
+//
+// The predictions are only computed for float64 data, and a BigO whose model
+// exceeds the float64 range at some N is listed without them.
+func (o *Classifier) DetailedSummary() string {
+	if !o.classified {
+		return o.Summary()
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(o.Summary())
+
+	if len(o.dataBig) > 0 {
+		fmt.Fprintf(&buf, "\npredictions are only shown for float64 data\n")
+
+		return buf.String()
+	}
+
+	Ns, vals := o.aggregatedValues()
+	for _, r := range o.ratings {
+		if r.bigO == Unrated {
+			continue
+		}
+
+		fmt.Fprintf(&buf, "\n%s:   score %0.8f   R²: %0.8f\n", r.bigO.label, r.score, r.RSquared())
+
+		if slices.ContainsFunc(Ns, func(N int) bool { return math.IsInf(r.bigO.predictFloat(N), 0) }) {
+			fmt.Fprintf(&buf, "  model exceeds the float64 range over these N\n")
+
+			continue
+		}
+
+		intercept, coefficient := r.bigO.fitCoefficient(Ns, vals)
+		fmt.Fprintf(&buf, "  fit: %.6g + %.6g·f(n)\n", intercept, coefficient)
+		fmt.Fprintf(&buf, "  %12s  %16s  %16s\n", "N", "actual", "predicted")
+		for i, N := range Ns {
+			predicted := intercept + coefficient*r.bigO.predictFloat(N)
+			fmt.Fprintf(&buf, "  %12d  %16.6g  %16.6g\n", N, vals[i], predicted)
+		}
 	}
 
 	return buf.String()
@@ -0,0 +1,107 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigo
+
+import (
+	"context"
+	"errors"
+)
+
+// DataPoint is a single measurement of Value at input size N, as consumed by
+// ClassifyStream.
+type DataPoint struct {
+	N     int
+	Value float64
+}
+
+// streamReclassifyGrowth is how much the number of distinct N must grow
+// since the last classification before ClassifyStream classifies again. This
+// throttles the work as the dataset gets large, since each Classify call
+// rates every BigO against all the data.
+const streamReclassifyGrowth = 1.25
+
+// ClassifyStream ingests data points from in as they arrive and emits an
+// updated top rating on the returned channel whenever the dataset has grown
+// enough to reclassify. Once there are enough distinct N to Classify (see
+// SetMinDataPoints), the data is classified each time the number of distinct
+// N grows by streamReclassifyGrowth. When in is closed, a final rating
+// covering all the points is emitted if anything arrived since the last one,
+// and the returned channel is closed. Canceling ctx stops ingestion and
+// closes the returned channel without a final rating.
+//
+// The Classifier is updated from a separate goroutine, so it must not be
+// used by the caller until the returned channel has been closed.
+func (o *Classifier) ClassifyStream(ctx context.Context, in <-chan DataPoint) (<-chan *Rating, error) {
+	if in == nil {
+		return nil, errors.New("ClassifyStream requires a non-nil input channel")
+	}
+
+	out := make(chan *Rating)
+
+	go func() {
+		defer close(out)
+
+		lastNs := 0
+		pending := false
+
+		// emit classifies the data and sends the new top rating, reporting
+		// whether the receiver is still listening.
+		emit := func() bool {
+			lastNs = o.numDistinctNs()
+			pending = false
+
+			// Errors ranking individual BigOs still leave a usable top
+			// rating, so only skip when nothing could be rated.
+			rating, _ := o.Classify()
+			if rating == nil || rating.bigO == Unrated {
+				return true
+			}
+
+			select {
+			case out <- rating:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case p, ok := <-in:
+				if !ok {
+					if pending && o.numDistinctNs() >= o.minDataPoints {
+						emit()
+					}
+
+					return
+				}
+
+				_ = o.AddDataPoint(p.N, p.Value)
+				pending = true
+
+				numNs := o.numDistinctNs()
+				if numNs >= o.minDataPoints && float64(numNs) >= float64(lastNs)*streamReclassifyGrowth {
+					if !emit() {
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigo
+
+import (
+	"context"
+	"testing"
+)
+
+func TestClassifierClassifyStream(t *testing.T) {
+	in := make(chan DataPoint)
+	go func() {
+		defer close(in)
+		for n := 100; n <= 3000; n += 100 {
+			in <- DataPoint{N: n, Value: float64(n * n)}
+		}
+	}()
+
+	c := NewClassifier()
+	out, err := c.ClassifyStream(context.Background(), in)
+	if err != nil {
+		t.Fatalf("ClassifyStream() returned error: %v", err)
+	}
+
+	var ratings []*Rating
+	for r := range out {
+		ratings = append(ratings, r)
+	}
+
+	// 30 distinct N throttled at 25% growth is far fewer than one
+	// classification per point.
+	if len(ratings) < 2 || len(ratings) >= 30 {
+		t.Fatalf("ClassifyStream() emitted %d ratings, want a throttled number between 2 and 29", len(ratings))
+	}
+
+	if got := ratings[len(ratings)-1].BigO(); got != Quadratic {
+		t.Errorf("final ClassifyStream() rating = %s, want %s", got.Label(), Quadratic.Label())
+	}
+
+	if got := len(c.data); got != 30 {
+		t.Errorf("after ClassifyStream() the Classifier has %d distinct N, want 30", got)
+	}
+}
+
+func TestClassifierClassifyStreamCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// The input is never closed, so only cancellation ends the stream.
+	in := make(chan DataPoint)
+
+	out, err := NewClassifier().ClassifyStream(ctx, in)
+	if err != nil {
+		t.Fatalf("ClassifyStream() returned error: %v", err)
+	}
+
+	in <- DataPoint{N: 10, Value: 10}
+	cancel()
+
+	for r := range out {
+		t.Errorf("ClassifyStream() emitted %s after too few points", r.BigO().Label())
+	}
+}
+
+func TestClassifierClassifyStreamNilChannel(t *testing.T) {
+	if _, err := NewClassifier().ClassifyStream(context.Background(), nil); err == nil {
+		t.Error("ClassifyStream(nil) returned no error, want an error")
+	}
+}
@@ -15,15 +15,24 @@
 package bigo
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 	"math/big"
+	"os"
 	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/rsned/stats/correlation"
 )
 
 var (
@@ -438,7 +447,7 @@ func TestClassify(t *testing.T) {
 			expectBigO: Linear,
 		},
 		{
-			name: "with big.Float data (not yet supported)",
+			name: "with mixed float64 and big.Float data",
 			setupData: func(c *Classifier) error {
 				_ = c.AddDataPoint(100, 100.0)
 				_ = c.AddDataPoint(200, 200.0)
@@ -447,9 +456,9 @@ func TestClassify(t *testing.T) {
 
 				return nil
 			},
-			wantErr:    true,
-			wantRating: false,
-			expectBigO: Unrated,
+			wantErr:    false,
+			wantRating: true,
+			expectBigO: Linear,
 		},
 		{
 			name: "already Classified - can re-Classify",
@@ -740,7 +749,7 @@ func TestClassifierNegativeIntegerHandlingBig(t *testing.T) {
 				{n: 0, val: big.NewFloat(0.0)},
 			},
 			expectedPoints: 0,
-			expectedErr:    false, // Classify() doesn't handle big.Float yet
+			expectedErr:    false,
 		},
 	}
 
@@ -1123,7 +1132,7 @@ func TestClassifierGetAllRatings(t *testing.T) {
 				return err
 			},
 			expectNil:        false,
-			expectNumRatings: 11, // Some BigO types get filtered out due to scaling cutoffs
+			expectNumRatings: 12, // Some BigO types get filtered out due to scaling cutoffs
 			expectSorted:     true,
 		},
 		{
@@ -1139,7 +1148,7 @@ func TestClassifierGetAllRatings(t *testing.T) {
 				return err
 			},
 			expectNil:        false,
-			expectNumRatings: 11, // Some BigO types get filtered out due to scaling cutoffs
+			expectNumRatings: 12, // Some BigO types get filtered out due to scaling cutoffs
 			expectSorted:     true,
 		},
 		{
@@ -1155,7 +1164,7 @@ func TestClassifierGetAllRatings(t *testing.T) {
 				return err
 			},
 			expectNil:        false,
-			expectNumRatings: 13, // All BigO types should be available for small input sizes
+			expectNumRatings: 14, // All BigO types should be available for small input sizes
 			expectSorted:     true,
 		},
 		{
@@ -1178,7 +1187,7 @@ func TestClassifierGetAllRatings(t *testing.T) {
 				return err
 			},
 			expectNil:        false,
-			expectNumRatings: 11, // Should have fresh ratings (not appended), some filtered due to scaling cutoffs
+			expectNumRatings: 12, // Should have fresh ratings (not appended), some filtered due to scaling cutoffs
 			expectSorted:     true,
 		},
 		{
@@ -1366,9 +1375,8 @@ func TestClassifierGetAllRatingsEmptyAfterReset(t *testing.T) {
 		return
 	}
 
-	// Create a new classifier (simulating reset)
-	c2 := NewClassifier()
-	ratingsAfterReset := c2.GetAllRatings()
+	c.Reset()
+	ratingsAfterReset := c.GetAllRatings()
 
 	if ratingsAfterReset != nil {
 		t.Errorf("expected nil ratings from new classifier, got %d ratings", len(ratingsAfterReset))
@@ -1519,3 +1527,2884 @@ func TestClassifierGetAllRatingsReClassificationReplacesRatings(t *testing.T) {
 			secondCount, expectedRange)
 	}
 }
+
+func TestClassifierPairwiseGrowthRatios(t *testing.T) {
+	c := NewClassifier()
+
+	// Quadratic data with a little measurement noise, N roughly doubling.
+	ns := []int{100, 200, 400, 800, 1600, 3000}
+	noise := []float64{1.01, 0.99, 1.02, 0.98, 1.00, 1.01}
+	for i, n := range ns {
+		_ = c.AddDataPoint(n, 3*float64(n*n)*noise[i])
+	}
+
+	nRatios, valRatios, err := c.PairwiseGrowthRatios()
+	if err != nil {
+		t.Fatalf("PairwiseGrowthRatios() returned error: %v", err)
+	}
+
+	if len(nRatios) != len(ns)-1 || len(valRatios) != len(ns)-1 {
+		t.Fatalf("PairwiseGrowthRatios() returned %d/%d ratios, want %d",
+			len(nRatios), len(valRatios), len(ns)-1)
+	}
+
+	const tolerance = 0.1
+	for i := range nRatios {
+		want := nRatios[i] * nRatios[i]
+		if math.Abs(valRatios[i]-want)/want > tolerance {
+			t.Errorf("valRatios[%d] = %0.3f, want ≈ nRatios[%d]² = %0.3f",
+				i, valRatios[i], i, want)
+		}
+	}
+}
+
+func TestClassifierPairwiseGrowthRatiosInsufficientData(t *testing.T) {
+	c := NewClassifier()
+	_ = c.AddDataPoint(100, 1.0)
+
+	if _, _, err := c.PairwiseGrowthRatios(); err == nil {
+		t.Errorf("PairwiseGrowthRatios() with one N expected error but got none")
+	}
+}
+
+func TestClassifyNLogLogN(t *testing.T) {
+	c := NewClassifier()
+
+	// Sieve of Eratosthenes shaped timings spanning several orders of
+	// magnitude so that the log log n factor is visible against linear.
+	for _, n := range []int{100, 1000, 10000, 100000, 1000000, 10000000} {
+		x := float64(n)
+		_ = c.AddDataPoint(n, 2.5*x*math.Log(math.Log(x)))
+	}
+
+	rating, err := c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	if rating.BigO() != NLogLogN {
+		t.Errorf("Classify() = %s, want %s", rating.BigO().Label(), NLogLogN.Label())
+	}
+
+	var linearScore, nLogLogNScore float64
+	for _, r := range c.GetAllRatings() {
+		switch r.BigO() {
+		case Linear:
+			linearScore = r.Score()
+		case NLogLogN:
+			nLogLogNScore = r.Score()
+		}
+	}
+
+	if nLogLogNScore <= linearScore {
+		t.Errorf("%s score %0.8f should be higher than %s score %0.8f",
+			NLogLogN.Label(), nLogLogNScore, Linear.Label(), linearScore)
+	}
+}
+
+func TestClassifierSetDescriptionFunc(t *testing.T) {
+	c := NewClassifier()
+	_ = c.AddDataPoint(100, 100.0)
+	_ = c.AddDataPoint(200, 200.0)
+	_ = c.AddDataPoint(400, 400.0)
+	_ = c.AddDataPoint(800, 800.0)
+	_ = c.AddDataPoint(1600, 1600.0)
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	// Default should use the built-in description.
+	if summary := c.Summary(); !strings.Contains(summary, Linear.Description()) {
+		t.Errorf("Summary() = %q, want it to contain the built-in description %q",
+			summary, Linear.Description())
+	}
+
+	spanish := map[*BigO]string{
+		Linear: "Un algoritmo con complejidad O(n) tiene un tiempo de ejecución que crece linealmente con el tamaño de la entrada.",
+	}
+	c.SetDescriptionFunc(func(b *BigO) string {
+		if d, ok := spanish[b]; ok {
+			return d
+		}
+
+		return b.Description()
+	})
+
+	summary := c.Summary()
+	if !strings.Contains(summary, spanish[Linear]) {
+		t.Errorf("Summary() = %q, want it to contain the custom description %q",
+			summary, spanish[Linear])
+	}
+
+	if strings.Contains(summary, Linear.Description()) {
+		t.Errorf("Summary() still contains the built-in description after SetDescriptionFunc")
+	}
+
+	// The shared BigO instance must not be modified.
+	if Linear.Description() == spanish[Linear] {
+		t.Errorf("SetDescriptionFunc modified the global BigO description")
+	}
+}
+
+func TestClassifierDisambiguateFlatLogVersusConstant(t *testing.T) {
+	c := NewClassifier()
+
+	// log(n) growth hidden under a large constant overhead across four
+	// orders of magnitude. The overhead keeps the coefficient of variation
+	// tiny, so the constant detector scores as well as the log fit.
+	for _, n := range []int{10, 30, 100, 300, 1000, 3000, 10000, 30000, 100000} {
+		_ = c.AddDataPoint(n, 1000+5*math.Log(float64(n)))
+	}
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	got, explanation, err := c.DisambiguateFlat()
+	if err != nil {
+		t.Fatalf("DisambiguateFlat() returned error: %v", err)
+	}
+
+	if got != Log {
+		t.Errorf("DisambiguateFlat() = %s, want %s (%s)", got.Label(), Log.Label(), explanation)
+	}
+
+	if explanation == "" {
+		t.Errorf("DisambiguateFlat() returned an empty explanation")
+	}
+}
+
+func TestClassifierDisambiguateFlatNoisyConstant(t *testing.T) {
+	c := NewClassifier()
+
+	vals := []float64{100.2, 99.8, 100.1, 99.9, 100.3, 99.7, 100.0, 99.9, 100.1}
+	for i, n := range []int{10, 30, 100, 300, 1000, 3000, 10000, 30000, 100000} {
+		_ = c.AddDataPoint(n, vals[i])
+	}
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	got, explanation, err := c.DisambiguateFlat()
+	if err != nil {
+		t.Fatalf("DisambiguateFlat() returned error: %v", err)
+	}
+
+	if got != Constant {
+		t.Errorf("DisambiguateFlat() = %s, want %s (%s)", got.Label(), Constant.Label(), explanation)
+	}
+}
+
+func TestClassifierDisambiguateFlatNotFlat(t *testing.T) {
+	c := NewClassifier()
+	for _, n := range []int{10, 100, 1000, 10000} {
+		_ = c.AddDataPoint(n, float64(n*n))
+	}
+
+	if _, _, err := c.DisambiguateFlat(); err == nil {
+		t.Errorf("DisambiguateFlat() before Classify() should return an error")
+	}
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	got, _, err := c.DisambiguateFlat()
+	if err != nil {
+		t.Fatalf("DisambiguateFlat() returned error: %v", err)
+	}
+
+	if got != Quadratic {
+		t.Errorf("DisambiguateFlat() = %s, want %s", got.Label(), Quadratic.Label())
+	}
+}
+
+func TestClassifierDisambiguateFlatBigFloat(t *testing.T) {
+	c := NewClassifier()
+	for _, n := range []int{10, 30, 100, 300, 1000} {
+		_ = c.AddDataPointBig(n, big.NewFloat(100+math.Log(math.Log(float64(n)))))
+	}
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	if _, _, err := c.DisambiguateFlat(); !errors.Is(err, ErrBigFloatUnsupported) {
+		t.Errorf("DisambiguateFlat() error = %v, want %v", err, ErrBigFloatUnsupported)
+	}
+}
+
+func TestClassifierVarianceReport(t *testing.T) {
+	c := NewClassifier()
+
+	_ = c.AddDataPoint(10, 100, 101, 99)
+	_ = c.AddDataPoint(20, 200, 202, 198)
+	_ = c.AddDataPoint(40, 400, 150, 650)
+	_ = c.AddDataPoint(80, 800, 804, 796)
+	// A single measurement has no variance and is left out of the report.
+	_ = c.AddDataPoint(160, 1600)
+
+	report := c.VarianceReport()
+
+	if len(report) != 4 {
+		t.Fatalf("VarianceReport() has %d entries, want 4: %v", len(report), report)
+	}
+
+	if _, ok := report[160]; ok {
+		t.Errorf("VarianceReport() should not include N with a single value")
+	}
+
+	for _, n := range []int{10, 20, 80} {
+		if report[n] > 0.01 {
+			t.Errorf("VarianceReport()[%d] = %0.4f, want < 0.01", n, report[n])
+		}
+	}
+
+	if report[40] < 0.4 {
+		t.Errorf("VarianceReport()[40] = %0.4f, want >= 0.4 for the noisy N", report[40])
+	}
+
+	// The median of the four CVs sits between the clean values.
+	median := c.MedianCV()
+	if median > 0.01 {
+		t.Errorf("MedianCV() = %0.4f, want < 0.01", median)
+	}
+}
+
+func TestClassifierMedianCVNoRepeats(t *testing.T) {
+	c := NewClassifier()
+	_ = c.AddDataPoint(10, 100)
+	_ = c.AddDataPoint(20, 200)
+
+	if got := c.MedianCV(); !math.IsNaN(got) {
+		t.Errorf("MedianCV() with no repeated measurements = %v, want NaN", got)
+	}
+}
+
+func TestClassifierSetBaseline(t *testing.T) {
+	ns := []int{100, 200, 400, 800, 1600, 3200, 6400}
+
+	// The harness overhead is large and jittery, which swamps the 3n signal
+	// in the raw timings. The baseline measures the same overhead.
+	overhead := []float64{52000, 47000, 55000, 44000, 53000, 46000, 58000}
+
+	raw := NewClassifier()
+	baseline := NewClassifier()
+	for i, n := range ns {
+		_ = raw.AddDataPoint(n, overhead[i]+3*float64(n))
+		_ = baseline.AddDataPoint(n, overhead[i])
+	}
+
+	rawRating, err := raw.Classify()
+	if err != nil {
+		t.Fatalf("Classify() without baseline returned error: %v", err)
+	}
+
+	adjusted := NewClassifier()
+	for i, n := range ns {
+		_ = adjusted.AddDataPoint(n, overhead[i]+3*float64(n))
+	}
+
+	adjusted.SetBaseline(baseline)
+
+	adjustedRating, err := adjusted.Classify()
+	if err != nil {
+		t.Fatalf("Classify() with baseline returned error: %v", err)
+	}
+
+	if adjustedRating.BigO() != Linear {
+		t.Errorf("Classify() with baseline = %s, want %s", adjustedRating.BigO().Label(), Linear.Label())
+	}
+
+	var rawLinear, adjustedLinear float64
+	for _, r := range raw.GetAllRatings() {
+		if r.BigO() == Linear {
+			rawLinear = r.Score()
+		}
+	}
+
+	for _, r := range adjusted.GetAllRatings() {
+		if r.BigO() == Linear {
+			adjustedLinear = r.Score()
+		}
+	}
+
+	if adjustedLinear <= rawLinear {
+		t.Errorf("%s score with baseline %0.8f should be higher than without %0.8f (raw winner %s)",
+			Linear.Label(), adjustedLinear, rawLinear, rawRating.BigO().Label())
+	}
+
+	// Clearing the baseline restores the raw values.
+	adjusted.SetBaseline(nil)
+
+	_, vals := adjusted.meanValues()
+	if vals[0] != overhead[0]+3*float64(ns[0]) {
+		t.Errorf("meanValues()[0] after clearing baseline = %v, want %v", vals[0], overhead[0]+3*float64(ns[0]))
+	}
+}
+
+func TestClassifierCoefficientCI(t *testing.T) {
+	c := NewClassifier()
+
+	// 3ns per element with about 1% of deterministic jitter.
+	for i, n := range []int{1000, 2000, 3000, 4000, 5000, 6000, 7000, 8000, 9000, 10000} {
+		base := 3 * float64(n)
+		_ = c.AddDataPoint(n,
+			base*(1+0.01*math.Sin(float64(i))),
+			base*(1-0.01*math.Cos(float64(i))),
+			base*(1+0.005*math.Sin(float64(3*i))))
+	}
+
+	if _, _, err := c.CoefficientCI(100); err == nil {
+		t.Errorf("CoefficientCI() before Classify() should return an error")
+	}
+
+	rating, err := c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	if rating.BigO() != Linear {
+		t.Fatalf("Classify() = %s, want %s", rating.BigO().Label(), Linear.Label())
+	}
+
+	low, high, err := c.CoefficientCI(500)
+	if err != nil {
+		t.Fatalf("CoefficientCI() returned error: %v", err)
+	}
+
+	if low > 3 || high < 3 {
+		t.Errorf("CoefficientCI() = [%0.4f, %0.4f], want an interval containing 3", low, high)
+	}
+
+	if high-low > 0.1 {
+		t.Errorf("CoefficientCI() = [%0.4f, %0.4f], width %0.4f is too wide for low-noise data", low, high, high-low)
+	}
+
+	// The resampling is seeded, so a second call gives the same interval.
+	low2, high2, err := c.CoefficientCI(500)
+	if err != nil {
+		t.Fatalf("CoefficientCI() second call returned error: %v", err)
+	}
+
+	if low != low2 || high != high2 {
+		t.Errorf("CoefficientCI() is not deterministic: [%v, %v] then [%v, %v]", low, high, low2, high2)
+	}
+
+	if _, _, err := c.CoefficientCI(0); err == nil {
+		t.Errorf("CoefficientCI(0) should return an error")
+	}
+}
+
+func TestClassifierObservedExponentPerDecade(t *testing.T) {
+	tests := []struct {
+		name    string
+		ns      []int
+		f       func(x float64) float64
+		wantMin float64
+		wantMax float64
+	}{
+		{
+			name:    "linear",
+			ns:      []int{10, 100, 1000, 10000},
+			f:       func(x float64) float64 { return 7 * x },
+			wantMin: 0.999,
+			wantMax: 1.001,
+		},
+		{
+			name:    "cubic",
+			ns:      []int{10, 20, 50, 100, 200, 500, 1000},
+			f:       func(x float64) float64 { return 0.5 * x * x * x },
+			wantMin: 2.999,
+			wantMax: 3.001,
+		},
+		{
+			name:    "cubic with lower order terms",
+			ns:      []int{100, 1000, 10000, 100000},
+			f:       func(x float64) float64 { return x*x*x + 50*x*x + 1000 },
+			wantMin: 2.9,
+			wantMax: 3.0,
+		},
+		{
+			name:    "exponential",
+			ns:      []int{10, 20, 30, 40, 50},
+			f:       func(x float64) float64 { return math.Pow(2, x) },
+			wantMin: 10,
+			wantMax: math.Inf(1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			for _, n := range tt.ns {
+				_ = c.AddDataPoint(n, tt.f(float64(n)))
+			}
+
+			got, err := c.ObservedExponentPerDecade()
+			if err != nil {
+				t.Fatalf("ObservedExponentPerDecade() returned error: %v", err)
+			}
+
+			if got < tt.wantMin || got > tt.wantMax {
+				t.Errorf("ObservedExponentPerDecade() = %0.4f, want in [%v, %v]", got, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestClassifierObservedExponentPerDecadeExponentialRises(t *testing.T) {
+	// For exponential growth the fitted exponent keeps increasing as larger
+	// N are included, which a polynomial never does.
+	c := NewClassifier()
+	for _, n := range []int{10, 20, 30} {
+		_ = c.AddDataPoint(n, math.Pow(2, float64(n)))
+	}
+
+	smaller, err := c.ObservedExponentPerDecade()
+	if err != nil {
+		t.Fatalf("ObservedExponentPerDecade() returned error: %v", err)
+	}
+
+	for _, n := range []int{40, 50, 60} {
+		_ = c.AddDataPoint(n, math.Pow(2, float64(n)))
+	}
+
+	larger, err := c.ObservedExponentPerDecade()
+	if err != nil {
+		t.Fatalf("ObservedExponentPerDecade() returned error: %v", err)
+	}
+
+	if larger <= smaller {
+		t.Errorf("ObservedExponentPerDecade() with larger N = %0.4f, want more than %0.4f", larger, smaller)
+	}
+}
+
+func TestClassifierObservedExponentPerDecadeErrors(t *testing.T) {
+	c := NewClassifier()
+	_ = c.AddDataPoint(10, 1)
+
+	if _, err := c.ObservedExponentPerDecade(); !errors.Is(err, ErrInsufficientData) {
+		t.Errorf("ObservedExponentPerDecade() with one N error = %v, want %v", err, ErrInsufficientData)
+	}
+
+	_ = c.AddDataPoint(20, 0)
+	if _, err := c.ObservedExponentPerDecade(); err == nil {
+		t.Errorf("ObservedExponentPerDecade() with a zero value should return an error")
+	}
+}
+
+func TestClassifierWinnerModelFunc(t *testing.T) {
+	tests := []struct {
+		name string
+		ns   []int
+		f    func(x float64) float64
+		want *BigO
+	}{
+		{
+			name: "linear",
+			ns:   []int{100, 200, 400, 800, 1600},
+			f:    func(x float64) float64 { return 3 * x },
+			want: Linear,
+		},
+		{
+			name: "quadratic",
+			ns:   []int{100, 200, 400, 800, 1600},
+			f:    func(x float64) float64 { return 0.25 * x * x },
+			want: Quadratic,
+		},
+		{
+			name: "constant",
+			ns:   []int{100, 200, 400, 800, 1600},
+			f:    func(x float64) float64 { return 42 + 0.1*math.Sin(x) },
+			want: Constant,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			if _, err := c.WinnerModelFunc(); !errors.Is(err, ErrNotClassified) {
+				t.Errorf("WinnerModelFunc() before Classify() error = %v, want %v", err, ErrNotClassified)
+			}
+
+			for _, n := range tt.ns {
+				_ = c.AddDataPoint(n, tt.f(float64(n)))
+			}
+
+			rating, err := c.Classify()
+			if err != nil {
+				t.Fatalf("Classify() returned error: %v", err)
+			}
+
+			if rating.BigO() != tt.want {
+				t.Fatalf("Classify() = %s, want %s", rating.BigO().Label(), tt.want.Label())
+			}
+
+			model, err := c.WinnerModelFunc()
+			if err != nil {
+				t.Fatalf("WinnerModelFunc() returned error: %v", err)
+			}
+
+			for _, n := range tt.ns {
+				want := tt.f(float64(n))
+				if got := model(float64(n)); math.Abs(got-want) > 0.01*want {
+					t.Errorf("model(%d) = %v, want %v", n, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestClassifierSnapshotRestore(t *testing.T) {
+	c := NewClassifier()
+	for _, n := range []int{100, 200, 400, 800, 1600} {
+		_ = c.AddDataPoint(n, float64(n*n), float64(n*n)+1)
+	}
+
+	_ = c.AddDataPointBig(3200, big.NewFloat(3200*3200))
+
+	rating, err := c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	if rating.BigO() != Quadratic {
+		t.Fatalf("Classify() = %s, want %s", rating.BigO().Label(), Quadratic.Label())
+	}
+
+	snap := c.Snapshot()
+
+	// Experiment: replace most of the data with linear values and
+	// reclassify.
+	for _, n := range []int{200, 400, 800} {
+		delete(c.data, n)
+	}
+
+	_ = c.AddDataPoint(100, 1e6)
+	c.dataBig[3200][0].SetFloat64(0)
+
+	_ = c.AddDataPoint(10000, 1)
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() after edits returned error: %v", err)
+	}
+
+	c.Restore(snap)
+
+	if len(c.data) != 5 {
+		t.Errorf("len(data) after Restore = %d, want 5", len(c.data))
+	}
+
+	if !cmp.Equal(c.data[100], []float64{10000, 10001}) {
+		t.Errorf("data[100] after Restore = %v, want [10000 10001]", c.data[100])
+	}
+
+	if got, _ := c.dataBig[3200][0].Float64(); got != 3200*3200 {
+		t.Errorf("dataBig[3200] after Restore = %v, want %v", got, 3200*3200)
+	}
+
+	if c.rating.BigO() != Quadratic {
+		t.Errorf("rating after Restore = %s, want %s", c.rating.BigO().Label(), Quadratic.Label())
+	}
+
+	// Reclassifying the restored data gives the original winner.
+	rating, err = c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() after Restore returned error: %v", err)
+	}
+
+	if rating.BigO() != Quadratic {
+		t.Errorf("Classify() after Restore = %s, want %s", rating.BigO().Label(), Quadratic.Label())
+	}
+
+	// Changes after a Restore do not leak back into the Snapshot.
+	_ = c.AddDataPoint(100, 5)
+	c.Restore(snap)
+
+	if !cmp.Equal(c.data[100], []float64{10000, 10001}) {
+		t.Errorf("data[100] after second Restore = %v, want [10000 10001]", c.data[100])
+	}
+
+	// Restoring nil leaves the Classifier alone.
+	c.Restore(nil)
+	if len(c.data) != 5 {
+		t.Errorf("len(data) after Restore(nil) = %d, want 5", len(c.data))
+	}
+}
+
+func TestClassifierDisambiguateExplosive(t *testing.T) {
+	tests := []struct {
+		name string
+		ns   []int
+		f    func(n int) float64
+		want *BigO
+	}{
+		{
+			name: "factorial over n=3..8",
+			ns:   []int{3, 4, 5, 6, 7, 8},
+			f:    func(n int) float64 { return 5 * factorial(n) },
+			want: Factorial,
+		},
+		{
+			name: "factorial with gaps",
+			ns:   []int{4, 6, 8, 10, 12},
+			f:    func(n int) float64 { return 0.2 * factorial(n) },
+			want: Factorial,
+		},
+		{
+			name: "exponential base 2",
+			ns:   []int{3, 4, 5, 6, 7, 8},
+			f:    func(n int) float64 { return 3 * math.Pow(2, float64(n)) },
+			want: Exponential,
+		},
+		{
+			name: "exponential base 3",
+			ns:   []int{5, 10, 15, 20},
+			f:    func(n int) float64 { return math.Pow(3, float64(n)) },
+			want: Exponential,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			for _, n := range tt.ns {
+				_ = c.AddDataPoint(n, tt.f(n))
+			}
+
+			if _, err := c.Classify(); err != nil {
+				t.Fatalf("Classify() returned error: %v", err)
+			}
+
+			if !isExplosiveBigO(c.rating.BigO()) {
+				t.Fatalf("Classify() = %s, want an explosive class", c.rating.BigO().Label())
+			}
+
+			got, explanation, err := c.DisambiguateExplosive()
+			if err != nil {
+				t.Fatalf("DisambiguateExplosive() returned error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("DisambiguateExplosive() = %s, want %s (%s)", got.Label(), tt.want.Label(), explanation)
+			}
+		})
+	}
+}
+
+func TestClassifierDisambiguateExplosiveNotExplosive(t *testing.T) {
+	c := NewClassifier()
+	if _, _, err := c.DisambiguateExplosive(); !errors.Is(err, ErrNotClassified) {
+		t.Errorf("DisambiguateExplosive() before Classify() error = %v, want %v", err, ErrNotClassified)
+	}
+
+	for _, n := range []int{100, 200, 400, 800} {
+		_ = c.AddDataPoint(n, float64(n))
+	}
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	got, _, err := c.DisambiguateExplosive()
+	if err != nil {
+		t.Fatalf("DisambiguateExplosive() returned error: %v", err)
+	}
+
+	if got != Linear {
+		t.Errorf("DisambiguateExplosive() = %s, want %s", got.Label(), Linear.Label())
+	}
+}
+
+func TestClassifierEffectiveComplexity(t *testing.T) {
+	tests := []struct {
+		name           string
+		f              func(n int) float64
+		wantAsymptotic *BigO
+		wantEffective  *BigO
+	}{
+		{
+			// Over n ≤ 50 the 1000·n term dominates so the data fits linear,
+			// but the slopes keep rising with n in the tail.
+			name:           "n^2 + 1000n",
+			f:              func(n int) float64 { return float64(n*n + 1000*n) },
+			wantAsymptotic: Quadratic,
+			wantEffective:  Linear,
+		},
+		{
+			name:           "pure n^2",
+			f:              func(n int) float64 { return float64(n * n) },
+			wantAsymptotic: Quadratic,
+			wantEffective:  Quadratic,
+		},
+		{
+			name:           "linear with offset",
+			f:              func(n int) float64 { return float64(3*n + 5) },
+			wantAsymptotic: Linear,
+			wantEffective:  Linear,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			for n := 1; n <= 50; n++ {
+				_ = c.AddDataPoint(n, tt.f(n))
+			}
+
+			_, _ = c.Classify()
+
+			asymptotic, effective, err := c.EffectiveComplexity()
+			if err != nil {
+				t.Fatalf("EffectiveComplexity() returned error: %v", err)
+			}
+
+			if asymptotic != tt.wantAsymptotic || effective != tt.wantEffective {
+				t.Errorf("EffectiveComplexity() = (%s, %s), want (%s, %s)",
+					asymptotic.Label(), effective.Label(), tt.wantAsymptotic.Label(), tt.wantEffective.Label())
+			}
+
+			note := fmt.Sprintf("behaves as %s in tested range; tail suggests %s",
+				tt.wantEffective.Label(), tt.wantAsymptotic.Label())
+			if got := strings.Contains(c.Summary(), note); got != (tt.wantAsymptotic != tt.wantEffective) {
+				t.Errorf("Summary() contains %q = %v, want %v", note, got, !got)
+			}
+		})
+	}
+}
+
+func TestClassifierEffectiveComplexityErrors(t *testing.T) {
+	c := NewClassifier()
+	if _, _, err := c.EffectiveComplexity(); !errors.Is(err, ErrNotClassified) {
+		t.Errorf("EffectiveComplexity() before Classify() error = %v, want %v", err, ErrNotClassified)
+	}
+
+	// Too few points to judge the tail reports the best fit for both.
+	for _, n := range []int{100, 200, 400, 800} {
+		_ = c.AddDataPoint(n, float64(n))
+	}
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	asymptotic, effective, err := c.EffectiveComplexity()
+	if err != nil {
+		t.Fatalf("EffectiveComplexity() returned error: %v", err)
+	}
+
+	if asymptotic != Linear || effective != Linear {
+		t.Errorf("EffectiveComplexity() = (%s, %s), want (%s, %s)",
+			asymptotic.Label(), effective.Label(), Linear.Label(), Linear.Label())
+	}
+}
+
+func TestClassifierIsMonotonic(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[int][]float64
+		want bool
+	}{
+		{
+			name: "empty",
+			data: map[int][]float64{},
+			want: true,
+		},
+		{
+			name: "increasing",
+			data: map[int][]float64{10: {1}, 20: {2}, 30: {3}},
+			want: true,
+		},
+		{
+			name: "flat steps",
+			data: map[int][]float64{10: {1}, 20: {1}, 30: {3}},
+			want: true,
+		},
+		{
+			// The single 0.5 at N=20 pulls its mean below N=10.
+			name: "mean decreases",
+			data: map[int][]float64{10: {2, 2}, 20: {0.5, 3}, 30: {4}},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			for n, vals := range tt.data {
+				_ = c.AddDataPoint(n, vals...)
+			}
+
+			if got := c.IsMonotonic(); got != tt.want {
+				t.Errorf("IsMonotonic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifierClassifyNonMonotonic(t *testing.T) {
+	// Linear growth except a cache effect making the largest N faster.
+	c := NewClassifier()
+	for n := 100; n <= 1000; n += 100 {
+		v := float64(n)
+		if n == 1000 {
+			v = 500
+		}
+
+		_ = c.AddDataPoint(n, v)
+	}
+
+	rating, _ := c.Classify()
+	if rating.BigO() == Unrated {
+		t.Fatalf("Classify() = %s, want a best-effort classification", rating.BigO().Label())
+	}
+
+	if !rating.NonMonotonic() {
+		t.Errorf("Classify().NonMonotonic() = false, want true")
+	}
+
+	if !strings.Contains(c.Summary(), "do not increase with N") {
+		t.Errorf("Summary() is missing the non-monotonic warning:\n%s", c.Summary())
+	}
+
+	// A clean dataset does not get flagged.
+	c = NewClassifier()
+	for n := 100; n <= 1000; n += 100 {
+		_ = c.AddDataPoint(n, float64(n))
+	}
+
+	if rating, _ := c.Classify(); rating.NonMonotonic() {
+		t.Errorf("Classify().NonMonotonic() on linear data = true, want false")
+	}
+}
+
+func TestClassifierRecords(t *testing.T) {
+	c := NewClassifier()
+	if got := c.Records(); len(got) != 0 {
+		t.Errorf("Records() on an empty Classifier = %v, want none", got)
+	}
+
+	_ = c.AddDataPoint(30, 3.5)
+	_ = c.AddDataPoint(10, 1.0, 1.2, 0.9)
+	_ = c.AddDataPoint(20, 2.1, 1.9)
+	_ = c.AddDataPointBig(20, big.NewFloat(2.0))
+	_ = c.AddDataPointBig(40, big.NewFloat(4.0))
+
+	want := []DataPoint{
+		{N: 10, Value: 1.0},
+		{N: 10, Value: 1.2},
+		{N: 10, Value: 0.9},
+		{N: 20, Value: 2.1},
+		{N: 20, Value: 1.9},
+		{N: 20, Value: 2.0},
+		{N: 30, Value: 3.5},
+		{N: 40, Value: 4.0},
+	}
+
+	got := c.Records()
+	if len(got) != 8 {
+		t.Errorf("len(Records()) = %d, want 8, the total number of values added", len(got))
+	}
+
+	if !cmp.Equal(got, want) {
+		t.Errorf("Records() = %v, want %v", got, want)
+	}
+
+	// Changing the result does not change the Classifier.
+	got[0].Value = 100
+	if c.Records()[0].Value != 1.0 {
+		t.Errorf("modifying the Records() result changed the stored data")
+	}
+}
+
+func TestClassifierWinnerMAPE(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       func(n int) float64
+		wantMin float64
+		wantMax float64
+	}{
+		{
+			name:    "clean linear",
+			f:       func(n int) float64 { return 3 * float64(n) },
+			wantMin: 0,
+			wantMax: 0.01,
+		},
+		{
+			// A large constant offset keeps the correlation with O(n) at
+			// 1.0, but the fit through the origin misses badly at small N.
+			name:    "mis-scaled linear",
+			f:       func(n int) float64 { return float64(n) + 5000 },
+			wantMin: 10,
+			wantMax: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			for n := 100; n <= 1000; n += 100 {
+				_ = c.AddDataPoint(n, tt.f(n))
+			}
+
+			rating, err := c.Classify()
+			if err != nil {
+				t.Fatalf("Classify() returned error: %v", err)
+			}
+
+			if rating.BigO() != Linear || math.Abs(rating.Score()-1) > 1e-9 {
+				t.Fatalf("Classify() = %v, want %s with a score of 1.0", rating, Linear.Label())
+			}
+
+			got, err := c.WinnerMAPE()
+			if err != nil {
+				t.Fatalf("WinnerMAPE() returned error: %v", err)
+			}
+
+			if got < tt.wantMin || got > tt.wantMax {
+				t.Errorf("WinnerMAPE() = %v, want between %v and %v", got, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestClassifierWinnerMAPENotClassified(t *testing.T) {
+	if _, err := NewClassifier().WinnerMAPE(); !errors.Is(err, ErrNotClassified) {
+		t.Errorf("WinnerMAPE() before Classify() error = %v, want %v", err, ErrNotClassified)
+	}
+}
+
+func TestClassifierSetDropFirstSample(t *testing.T) {
+	// A constant time operation whose first, cold-cache run at each N costs
+	// extra in proportion to N. Left in, the cold runs make the means grow.
+	newClassifier := func() *Classifier {
+		c := NewClassifier()
+		for n := 100; n <= 1000; n += 100 {
+			warm := 100 + math.Sin(float64(n))
+			_ = c.AddDataPoint(n, 100+5*float64(n), warm, warm+0.5, warm-0.5)
+		}
+
+		// A single value is kept even when dropping the first sample.
+		_ = c.AddDataPoint(1100, 100)
+
+		return c
+	}
+
+	c := newClassifier()
+	rating, _ := c.Classify()
+	if rating.BigO() == Constant {
+		t.Fatalf("Classify() with cold samples = %s, want a growing class", rating.BigO().Label())
+	}
+
+	c = newClassifier()
+	c.SetDropFirstSample(true)
+
+	rating, err := c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	if rating.BigO() != Constant {
+		t.Errorf("Classify() with SetDropFirstSample(true) = %s, want %s", rating.BigO().Label(), Constant.Label())
+	}
+
+	if got := c.numDistinctNs(); got != 11 {
+		t.Errorf("numDistinctNs() = %d, want 11", got)
+	}
+
+	// The stored data is unchanged, so turning it back off restores the
+	// original classification.
+	c.SetDropFirstSample(false)
+	if rating, _ := c.Classify(); rating.BigO() == Constant {
+		t.Errorf("Classify() after SetDropFirstSample(false) = %s, want a growing class", rating.BigO().Label())
+	}
+}
+
+func TestClassifierSuggestNextN(t *testing.T) {
+	if _, err := NewClassifier().SuggestNextN(); !errors.Is(err, ErrNotClassified) {
+		t.Errorf("SuggestNextN() before Classify() error = %v, want %v", err, ErrNotClassified)
+	}
+
+	tests := []struct {
+		name    string
+		f       func(n int) float64
+		wantMin int
+		wantMax int
+	}{
+		{
+			// Over 100-1000, O(n) and O(n log n) score nearly the same and
+			// log n only doubles from ln(1000) at around N=10^6.
+			name:    "linear and linearithmic ambiguity",
+			f:       func(n int) float64 { return float64(n) },
+			wantMin: 100 * 1000,
+			wantMax: 10 * 1000 * 1000,
+		},
+		{
+			// O(2^n) pulls away from everything else quickly, so doubling
+			// the span is plenty.
+			name:    "decisive exponential",
+			f:       func(n int) float64 { return math.Pow(2, float64(n)) },
+			wantMin: 2 * 1000,
+			wantMax: 2 * 1000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			for n := 100; n <= 1000; n += 100 {
+				_ = c.AddDataPoint(n, tt.f(n))
+			}
+
+			_, _ = c.Classify()
+
+			got, err := c.SuggestNextN()
+			if err != nil {
+				t.Fatalf("SuggestNextN() returned error: %v", err)
+			}
+
+			if got < tt.wantMin || got > tt.wantMax {
+				t.Errorf("SuggestNextN() = %d, want between %d and %d", got, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestClassifierReset(t *testing.T) {
+	c := NewClassifier()
+
+	// Reset on an empty Classifier is safe.
+	c.Reset()
+	if !cmp.Equal(c, NewClassifier(), cmp.AllowUnexported(Classifier{}, Rating{}, BigO{}), cmpopts.IgnoreFields(BigO{}, "funcFloatFloat", "funcFloatBig", "funcBigBig")) {
+		t.Errorf("Reset() on an empty Classifier is not equivalent to NewClassifier()")
+	}
+
+	for _, n := range []int{100, 200, 300, 400} {
+		_ = c.AddDataPoint(n, float64(n))
+		_ = c.AddDataPointBig(n, big.NewFloat(float64(n)))
+	}
+
+	c.SetDropFirstSample(true)
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	c.Reset()
+
+	if got := c.GetAllRatings(); got != nil {
+		t.Errorf("GetAllRatings() after Reset() = %v, want nil", got)
+	}
+
+	if got := c.Summary(); got != "Not Classified yet" {
+		t.Errorf("Summary() after Reset() = %q, want %q", got, "Not Classified yet")
+	}
+
+	if len(c.data) != 0 || len(c.dataBig) != 0 || len(c.ratings) != 0 || c.dropFirstSample {
+		t.Errorf("Reset() left data = %v, dataBig = %v, ratings = %v, dropFirstSample = %v",
+			c.data, c.dataBig, c.ratings, c.dropFirstSample)
+	}
+
+	// The Classifier can be reused for a new dataset.
+	for _, n := range []int{100, 200, 300, 400} {
+		_ = c.AddDataPoint(n, float64(n*n))
+	}
+
+	rating, err := c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() after Reset() returned error: %v", err)
+	}
+
+	if rating.BigO() != Quadratic {
+		t.Errorf("Classify() after Reset() = %s, want %s", rating.BigO().Label(), Quadratic.Label())
+	}
+}
+
+func TestClassifierTopRatings(t *testing.T) {
+	c := NewClassifier()
+	if got := c.TopRatings(3); got != nil {
+		t.Errorf("TopRatings(3) before Classify() = %v, want nil", got)
+	}
+
+	for n := 100; n <= 1000; n += 100 {
+		_ = c.AddDataPoint(n, float64(n*n))
+	}
+
+	best, err := c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	all := c.GetAllRatings()
+
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{n: -1, want: 0},
+		{n: 0, want: 0},
+		{n: 1, want: 1},
+		{n: 3, want: 3},
+		{n: len(all) + 5, want: len(all)},
+	}
+
+	for _, tt := range tests {
+		got := c.TopRatings(tt.n)
+		if len(got) != tt.want {
+			t.Errorf("len(TopRatings(%d)) = %d, want %d", tt.n, len(got), tt.want)
+
+			continue
+		}
+
+		if len(got) > 0 && got[0].BigO() != best.BigO() {
+			t.Errorf("TopRatings(%d)[0] = %s, want the best rating %s", tt.n, got[0].BigO().Label(), best.BigO().Label())
+		}
+
+		for i := 1; i < len(got); i++ {
+			if got[i].Score() > got[i-1].Score() {
+				t.Errorf("TopRatings(%d) is not sorted by descending score: %v", tt.n, got)
+			}
+		}
+	}
+
+	// Modifying the result does not affect the Classifier.
+	top := c.TopRatings(2)
+	top[0] = nil
+	if c.TopRatings(2)[0] == nil {
+		t.Errorf("modifying the TopRatings() result changed internal state")
+	}
+}
+
+func TestClassifierClassifyWithConfidence(t *testing.T) {
+	c := NewClassifier()
+	_ = c.AddDataPoint(100, 1)
+	_ = c.AddDataPoint(200, 2)
+
+	_, wantErr := c.deepCopy().Classify()
+	rating, margin, err := c.ClassifyWithConfidence()
+	if !errors.Is(err, ErrInsufficientData) || err.Error() != wantErr.Error() {
+		t.Errorf("ClassifyWithConfidence() with 2 points error = %v, want the same error as Classify() (%v)", err, wantErr)
+	}
+
+	if rating.BigO() != Unrated || margin != 0 {
+		t.Errorf("ClassifyWithConfidence() with 2 points = %s, %v, want %s, 0", rating.BigO().Label(), margin, Unrated.Label())
+	}
+
+	tests := []struct {
+		name      string
+		f         func(n int) float64
+		want      *BigO
+		minMargin float64
+		maxMargin float64
+	}{
+		{
+			// O(n) and O(n log n) are nearly indistinguishable over 100-1000.
+			name:      "ambiguous linear",
+			f:         func(n int) float64 { return float64(n) },
+			want:      Linear,
+			minMargin: 0,
+			maxMargin: 0.01,
+		},
+		{
+			// Nothing growing fits flat data nearly as well as O(1).
+			name:      "decisive constant",
+			f:         func(n int) float64 { return 100 + math.Sin(float64(n)) },
+			want:      Constant,
+			minMargin: 0.05,
+			maxMargin: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			for n := 100; n <= 1000; n += 100 {
+				_ = c.AddDataPoint(n, tt.f(n))
+			}
+
+			rating, margin, err := c.ClassifyWithConfidence()
+			if err != nil {
+				t.Fatalf("ClassifyWithConfidence() returned error: %v", err)
+			}
+
+			if rating.BigO() != tt.want {
+				t.Errorf("ClassifyWithConfidence() = %s, want %s", rating.BigO().Label(), tt.want.Label())
+			}
+
+			if margin < tt.minMargin || margin > tt.maxMargin {
+				t.Errorf("ClassifyWithConfidence() margin = %v, want between %v and %v", margin, tt.minMargin, tt.maxMargin)
+			}
+		})
+	}
+}
+
+func TestClassifierAddWeightedDataPointErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		weight float64
+	}{
+		{name: "zero weight", weight: 0},
+		{name: "negative weight", weight: -1},
+		{name: "NaN weight", weight: math.NaN()},
+		{name: "infinite weight", weight: math.Inf(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			if err := c.AddWeightedDataPoint(10, tt.weight, 1.0); err == nil {
+				t.Errorf("AddWeightedDataPoint(10, %v) should have returned an error", tt.weight)
+			}
+
+			if len(c.data) != 0 || len(c.weightSums) != 0 {
+				t.Errorf("AddWeightedDataPoint(10, %v) stored the point: data = %v, weights = %v", tt.weight, c.data, c.weightSums)
+			}
+		})
+	}
+}
+
+func TestClassifierAddWeightedDataPoint(t *testing.T) {
+	// Quadratic data with one wildly noisy measurement at the smallest N.
+	noisy := func(n int) float64 {
+		if n == 100 {
+			return 500000
+		}
+
+		return float64(n * n)
+	}
+
+	tests := []struct {
+		name       string
+		lowNWeight float64
+		want       *BigO
+	}{
+		{name: "unit weight matches unweighted", lowNWeight: 1, want: nil},
+		{name: "downweighted noisy point", lowNWeight: 0.001, want: Quadratic},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unweighted := NewClassifier()
+			weighted := NewClassifier()
+			for n := 100; n <= 1000; n += 100 {
+				_ = unweighted.AddDataPoint(n, noisy(n))
+
+				weight := 1.0
+				if n == 100 {
+					weight = tt.lowNWeight
+				}
+
+				if err := weighted.AddWeightedDataPoint(n, weight, noisy(n)); err != nil {
+					t.Fatalf("AddWeightedDataPoint(%d, %v) returned error: %v", n, weight, err)
+				}
+			}
+
+			want, err := unweighted.Classify()
+			if err != nil {
+				t.Fatalf("unweighted Classify() returned error: %v", err)
+			}
+
+			got, err := weighted.Classify()
+			if err != nil {
+				t.Fatalf("weighted Classify() returned error: %v", err)
+			}
+
+			if tt.want == nil {
+				if got.BigO() != want.BigO() || got.Score() != want.Score() {
+					t.Errorf("weighted Classify() = %s (%v), want %s (%v)", got.BigO().Label(), got.Score(), want.BigO().Label(), want.Score())
+				}
+
+				return
+			}
+
+			if got.BigO() != tt.want {
+				t.Errorf("weighted Classify() = %s, want %s (unweighted gave %s)", got.BigO().Label(), tt.want.Label(), want.BigO().Label())
+			}
+
+			if want.BigO() == tt.want {
+				t.Errorf("unweighted Classify() = %s, expected the noisy point to change it", want.BigO().Label())
+			}
+		})
+	}
+}
+
+func TestClassifierAddWeightedDataPointMixed(t *testing.T) {
+	c := NewClassifier()
+	_ = c.AddDataPoint(10, 1, 2)
+	if err := c.AddWeightedDataPoint(10, 0.5, 3, 4); err != nil {
+		t.Fatalf("AddWeightedDataPoint() returned error: %v", err)
+	}
+
+	_ = c.AddDataPoint(10, 5)
+	_ = c.AddDataPoint(20, 6)
+
+	weights, weighted := c.weightsFor([]int{10, 20})
+	if !weighted {
+		t.Errorf("weightsFor() reported no weights")
+	}
+
+	// (1 + 1 + 0.5 + 0.5 + 1) / 5 at N=10, and the default of 1 at N=20.
+	if want := []float64{0.8, 1}; !cmp.Equal(weights, want) {
+		t.Errorf("weightsFor() = %v, want %v", weights, want)
+	}
+}
+
+func TestRatingRSquared(t *testing.T) {
+	tests := []struct {
+		name   string
+		rating *Rating
+		want   float64
+	}{
+		{
+			name:   "correlation is squared",
+			rating: &Rating{bigO: Linear, score: 0.9, nonMonotonic: false},
+			want:   0.81,
+		},
+		{
+			name:   "negative correlation is squared",
+			rating: &Rating{bigO: Quadratic, score: -0.5, nonMonotonic: false},
+			want:   0.25,
+		},
+		{
+			name:   "constant keeps its CV based score",
+			rating: &Rating{bigO: Constant, score: 0.9, nonMonotonic: false},
+			want:   0.9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rating.RSquared(); math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("RSquared() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifierSummaryShowsRSquared(t *testing.T) {
+	c := NewClassifier()
+	for n := 100; n <= 1000; n += 100 {
+		_ = c.AddDataPoint(n, float64(n*n))
+	}
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	summary := c.Summary()
+	for _, r := range c.GetAllRatings() {
+		want := fmt.Sprintf("%0.8f   R²: %0.8f", r.Score(), r.RSquared())
+		if !strings.Contains(summary, want) {
+			t.Errorf("Summary() = %q, want it to contain %q for %s", summary, want, r.BigO().Label())
+		}
+	}
+}
+
+func TestClassifierSetPolynomialBigO(t *testing.T) {
+	custom, err := NewPolynomialBigO(3.5)
+	if err != nil {
+		t.Fatalf("NewPolynomialBigO(3.5) returned error: %v", err)
+	}
+
+	c := NewClassifier()
+	for n := 10; n <= 100; n += 10 {
+		_ = c.AddDataPoint(n, math.Pow(float64(n), 3.5))
+	}
+
+	before, err := c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	if before.BigO() == custom {
+		t.Fatalf("Classify() picked the custom BigO before it was set")
+	}
+
+	if err := c.SetPolynomialBigO(custom); err != nil {
+		t.Fatalf("SetPolynomialBigO(%s) returned error: %v", custom.Label(), err)
+	}
+
+	after, err := c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	if after.BigO() != custom {
+		t.Errorf("Classify() with SetPolynomialBigO = %s, want %s", after.BigO().Label(), custom.Label())
+	}
+
+	for _, r := range c.GetAllRatings() {
+		if r.BigO() == Polynomial {
+			t.Errorf("GetAllRatings() still includes %s after SetPolynomialBigO", Polynomial.Label())
+		}
+	}
+
+	if err := c.SetPolynomialBigO(nil); err != nil {
+		t.Fatalf("SetPolynomialBigO(nil) returned error: %v", err)
+	}
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	for _, r := range c.GetAllRatings() {
+		if r.BigO() == custom {
+			t.Errorf("GetAllRatings() still includes %s after SetPolynomialBigO(nil)", custom.Label())
+		}
+	}
+}
+
+func TestClassifierSetPolynomialBigOErrors(t *testing.T) {
+	custom, err := NewPolynomialBigO(4.5)
+	if err != nil {
+		t.Fatalf("NewPolynomialBigO(4.5) returned error: %v", err)
+	}
+
+	c := NewClassifier()
+	if err := c.RegisterBigO(custom); err != nil {
+		t.Fatalf("RegisterBigO(%s) returned error: %v", custom.Label(), err)
+	}
+
+	tests := []struct {
+		name string
+		o    *BigO
+	}{
+		{
+			name: "collides with built-in",
+			o:    newBigO(Linearithmic.rank, "O(n^1)", "collides with linearithmic", func(x float64) float64 { return x }),
+		},
+		{
+			name: "collides with registered",
+			o:    newBigO(custom.rank, "O(n^4.5)'", "collides with custom", func(x float64) float64 { return x }),
+		},
+		{
+			name: "already registered",
+			o:    custom,
+		},
+		{
+			name: "missing model",
+			o:    newBigO(1000, "O(?)'", "missing model", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := c.SetPolynomialBigO(tt.o); err == nil {
+				t.Errorf("SetPolynomialBigO(%s) should have returned an error", tt.o.Label())
+			}
+
+			if c.polynomial != nil {
+				t.Errorf("SetPolynomialBigO(%s) changed the polynomial BigO on error", tt.o.Label())
+			}
+		})
+	}
+
+	// A degree 4 BigO shares Polynomial's rank, which it replaces.
+	quartic, err := NewPolynomialBigO(4)
+	if err != nil {
+		t.Fatalf("NewPolynomialBigO(4) returned error: %v", err)
+	}
+
+	if err := c.SetPolynomialBigO(quartic); err != nil {
+		t.Errorf("SetPolynomialBigO(%s) returned error: %v", quartic.Label(), err)
+	}
+
+	if err := c.SetPolynomialBigO(quartic); err != nil {
+		t.Errorf("SetPolynomialBigO(%s) again returned error: %v", quartic.Label(), err)
+	}
+}
+
+func TestClassifierEstimateExponent(t *testing.T) {
+	tests := []struct {
+		name    string
+		ns      []int
+		f       func(x float64) float64
+		wantMin float64
+		wantMax float64
+	}{
+		{
+			name:    "linear",
+			ns:      []int{10, 100, 1000, 10000},
+			f:       func(x float64) float64 { return 3 * x },
+			wantMin: 0.999,
+			wantMax: 1.001,
+		},
+		{
+			name:    "quadratic with noise",
+			ns:      []int{100, 200, 300, 400, 500, 600, 700, 800},
+			f:       func(x float64) float64 { return x * x * (1 + 0.05*math.Sin(x)) },
+			wantMin: 1.9,
+			wantMax: 2.1,
+		},
+		{
+			name:    "fractional degree",
+			ns:      []int{10, 20, 40, 80, 160},
+			f:       func(x float64) float64 { return math.Pow(x, 3.5) },
+			wantMin: 3.499,
+			wantMax: 3.501,
+		},
+		{
+			name: "non-positive values are skipped",
+			ns:   []int{5, 10, 100, 1000, 10000},
+			f: func(x float64) float64 {
+				if x == 5 {
+					return 0
+				}
+
+				return x * x
+			},
+			wantMin: 1.999,
+			wantMax: 2.001,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			for _, n := range tt.ns {
+				_ = c.AddDataPoint(n, tt.f(float64(n)))
+			}
+
+			got, err := c.EstimateExponent()
+			if err != nil {
+				t.Fatalf("EstimateExponent() returned error: %v", err)
+			}
+
+			if got < tt.wantMin || got > tt.wantMax {
+				t.Errorf("EstimateExponent() = %0.4f, want in [%v, %v]", got, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestClassifierEstimateExponentErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(c *Classifier)
+		want  error
+	}{
+		{
+			name: "too few points",
+			setup: func(c *Classifier) {
+				_ = c.AddDataPoint(10, 100)
+				_ = c.AddDataPoint(20, 400)
+			},
+			want: ErrInsufficientData,
+		},
+		{
+			name: "too few positive values",
+			setup: func(c *Classifier) {
+				_ = c.AddDataPoint(10, 100)
+				_ = c.AddDataPoint(20, 400)
+				_ = c.AddDataPoint(30, 0)
+				_ = c.AddDataPoint(40, -5)
+			},
+			want: ErrInsufficientData,
+		},
+		{
+			name: "big.Float data",
+			setup: func(c *Classifier) {
+				for _, n := range []int{10, 20, 30} {
+					_ = c.AddDataPointBig(n, big.NewFloat(float64(n)))
+				}
+			},
+			want: ErrBigFloatUnsupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			tt.setup(c)
+
+			if _, err := c.EstimateExponent(); !errors.Is(err, tt.want) {
+				t.Errorf("EstimateExponent() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifierGrowthCategory(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(x float64) float64
+		want string
+	}{
+		{name: "constant", f: func(x float64) float64 { return 42 }, want: "sublinear"},
+		{name: "logarithmic", f: math.Log, want: "sublinear"},
+		{name: "square root", f: math.Sqrt, want: "sublinear"},
+		{name: "linear", f: func(x float64) float64 { return 5 * x }, want: "linear"},
+		{name: "linearithmic", f: func(x float64) float64 { return x * math.Log(x) }, want: "superlinear"},
+		{name: "quadratic", f: func(x float64) float64 { return x * x }, want: "superlinear"},
+		{name: "exponential", f: math.Exp2, want: "superlinear"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			for n := 100; n <= 1000; n += 100 {
+				_ = c.AddDataPoint(n, tt.f(float64(n)))
+			}
+
+			got, err := c.GrowthCategory()
+			if err != nil {
+				t.Fatalf("GrowthCategory() returned error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("GrowthCategory() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewClassifier().GrowthCategory(); !errors.Is(err, ErrInsufficientData) {
+		t.Errorf("GrowthCategory() on an empty Classifier error = %v, want %v", err, ErrInsufficientData)
+	}
+}
+
+func TestClassifierSetCorrelationMethod(t *testing.T) {
+	c := NewClassifier()
+	for _, m := range []correlation.Type{correlation.KendallTau, correlation.GoodmanKruskal} {
+		if err := c.SetCorrelationMethod(m); err == nil {
+			t.Errorf("SetCorrelationMethod(%s) should have returned an error", m)
+		}
+	}
+
+	if c.correlationMethod != correlation.Pearson {
+		t.Errorf("correlationMethod = %s after rejected methods, want %s", c.correlationMethod, correlation.Pearson)
+	}
+
+	for _, m := range []correlation.Type{correlation.Spearman, correlation.Pearson} {
+		if err := c.SetCorrelationMethod(m); err != nil {
+			t.Errorf("SetCorrelationMethod(%s) returned error: %v", m, err)
+		}
+	}
+}
+
+func TestClassifierSpearmanIsRobustToOutliers(t *testing.T) {
+	files := []string{
+		"testdata/example_linear_time.csv",
+		"testdata/example_quadratic_time.csv",
+		"testdata/example_cubic_time.csv",
+	}
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			for _, m := range []correlation.Type{correlation.Pearson, correlation.Spearman} {
+				clean := NewClassifier()
+				if err := clean.LoadCSV(file, true, ','); err != nil {
+					t.Fatalf("LoadCSV(%q) returned error: %v", file, err)
+				}
+
+				// Add a single wild outlier at the smallest N.
+				noisy := clean.deepCopy()
+				Ns, vals := noisy.meanValues()
+				_ = noisy.AddDataPoint(Ns[0], vals[len(vals)-1]*100)
+
+				for _, c := range []*Classifier{clean, noisy} {
+					if err := c.SetCorrelationMethod(m); err != nil {
+						t.Fatalf("SetCorrelationMethod(%s) returned error: %v", m, err)
+					}
+
+					if _, err := c.Classify(); err != nil {
+						t.Fatalf("Classify() with %s returned error: %v", m, err)
+					}
+				}
+
+				// Compare the scores of the class the clean data fits.
+				want := clean.rating.bigO
+				var cleanScore, noisyScore float64
+				for _, r := range clean.GetAllRatings() {
+					if r.BigO() == want {
+						cleanScore = r.Score()
+					}
+				}
+
+				for _, r := range noisy.GetAllRatings() {
+					if r.BigO() == want {
+						noisyScore = r.Score()
+					}
+				}
+
+				drop := cleanScore - noisyScore
+				switch m {
+				case correlation.Pearson:
+					if drop < 0.5 {
+						t.Errorf("%s score for %s dropped by %v with an outlier, want at least 0.5", m, want.Label(), drop)
+					}
+				case correlation.Spearman:
+					if drop > 0.5 {
+						t.Errorf("%s score for %s dropped by %v with an outlier, want at most 0.5", m, want.Label(), drop)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestClassifierSetMinDataPoints(t *testing.T) {
+	for _, n := range []int{-1, 0, 2} {
+		if err := NewClassifier().SetMinDataPoints(n); err == nil {
+			t.Errorf("SetMinDataPoints(%d) should have returned an error", n)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		min     int
+		numNs   int
+		wantErr bool
+	}{
+		{name: "default minimum met", min: 3, numNs: 3, wantErr: false},
+		{name: "default minimum not met", min: 3, numNs: 2, wantErr: true},
+		{name: "raised minimum met", min: 6, numNs: 6, wantErr: false},
+		{name: "raised minimum not met", min: 6, numNs: 5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			if err := c.SetMinDataPoints(tt.min); err != nil {
+				t.Fatalf("SetMinDataPoints(%d) returned error: %v", tt.min, err)
+			}
+
+			for n := 1; n <= tt.numNs; n++ {
+				_ = c.AddDataPoint(n*100, float64(n))
+			}
+
+			_, err := c.Classify()
+			if !tt.wantErr {
+				if err != nil {
+					t.Errorf("Classify() returned error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, ErrInsufficientData) {
+				t.Fatalf("Classify() error = %v, want %v", err, ErrInsufficientData)
+			}
+
+			if want := fmt.Sprintf("need at least %d", tt.min); !strings.Contains(err.Error(), want) {
+				t.Errorf("Classify() error = %q, want it to mention %q", err, want)
+			}
+		})
+	}
+}
+
+func TestClassifierClone(t *testing.T) {
+	c := NewClassifier()
+	for n := 100; n <= 1000; n += 100 {
+		_ = c.AddDataPoint(n, float64(n))
+		_ = c.AddDataPointBig(n, big.NewFloat(float64(n)))
+	}
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	wantRatings := slices.Clone(c.GetAllRatings())
+
+	clone := c.Clone()
+	if !slices.Equal(clone.GetAllRatings(), wantRatings) {
+		t.Errorf("Clone().GetAllRatings() differs from the original")
+	}
+
+	// Diverge the clone into quadratic data and reclassify it.
+	for n := 100; n <= 1000; n += 100 {
+		_ = clone.AddDataPoint(n, float64(n*n))
+		clone.dataBig[n][0].SetFloat64(float64(n * n))
+	}
+
+	if _, err := clone.Classify(); err != nil {
+		t.Fatalf("Classify() on the clone returned error: %v", err)
+	}
+
+	if len(c.data[100]) != 1 {
+		t.Errorf("adding data to the clone changed the original: %v", c.data[100])
+	}
+
+	if got, _ := c.dataBig[100][0].Float64(); got != 100 {
+		t.Errorf("changing big.Float data in the clone changed the original to %v", got)
+	}
+
+	if got := c.GetAllRatings(); !slices.Equal(got, wantRatings) {
+		t.Errorf("classifying the clone changed the original's ratings")
+	}
+
+	if clone.rating.bigO == c.rating.bigO {
+		t.Errorf("the clone rated %s after diverging, want it to differ from the original", clone.rating.bigO.Label())
+	}
+}
+
+func TestClassifierClassifyMixedWideRange(t *testing.T) {
+	// N spans a factor of 10^5, well past the float64 cutoffs of the fast
+	// growing classes such as O(2^n) once N is scaled by its smallest value.
+	c := NewClassifier()
+	for _, n := range []int{10, 100, 1000, 10000, 100000, 1000000} {
+		v := float64(n) * math.Log(float64(n))
+		if n%1000 == 0 {
+			_ = c.AddDataPointBig(n, big.NewFloat(v))
+		} else {
+			_ = c.AddDataPoint(n, v)
+		}
+	}
+
+	got, err := c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	if got.BigO() != Linearithmic {
+		t.Errorf("Classify() = %s, want %s", got.BigO().Label(), Linearithmic.Label())
+	}
+}
+
+func TestClassifierClassifyBigUnsupportedSettings(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(c *Classifier)
+	}{
+		{
+			name: "weighted",
+			setup: func(c *Classifier) {
+				_ = c.AddWeightedDataPoint(50, 0.5, 50)
+			},
+		},
+		{
+			name: "median aggregation",
+			setup: func(c *Classifier) {
+				c.SetAggregation(AggregationMedian)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			for _, n := range []int{10, 20, 30, 40} {
+				_ = c.AddDataPointBig(n, big.NewFloat(float64(n)))
+			}
+
+			tt.setup(c)
+
+			if _, err := c.Classify(); !errors.Is(err, ErrBigFloatUnsupported) {
+				t.Errorf("Classify() error = %v, want %v", err, ErrBigFloatUnsupported)
+			}
+		})
+	}
+}
+
+func TestClassifierRemoveDataPoint(t *testing.T) {
+	c := NewClassifier()
+	for n := 100; n <= 500; n += 100 {
+		_ = c.AddDataPoint(n, float64(n), float64(n)+1)
+	}
+
+	_ = c.AddDataPointBig(300, big.NewFloat(300))
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	// Weights can't be classified with big.Float data, so add them after.
+	if err := c.AddWeightedDataPoint(400, 0.5, 400); err != nil {
+		t.Fatalf("AddWeightedDataPoint() returned error: %v", err)
+	}
+
+	if c.RemoveDataPoint(999) {
+		t.Errorf("RemoveDataPoint(999) = true for an N that was never added")
+	}
+
+	if !c.classified {
+		t.Errorf("RemoveDataPoint(999) discarded the classification")
+	}
+
+	for _, n := range []int{300, 400} {
+		if !c.RemoveDataPoint(n) {
+			t.Errorf("RemoveDataPoint(%d) = false, want true", n)
+		}
+
+		_, inData := c.data[n]
+		_, inDataBig := c.dataBig[n]
+		_, inWeights := c.weightSums[n]
+		if inData || inDataBig || inWeights {
+			t.Errorf("RemoveDataPoint(%d) left values behind", n)
+		}
+
+		if c.RemoveDataPoint(n) {
+			t.Errorf("RemoveDataPoint(%d) a second time = true, want false", n)
+		}
+	}
+
+	if got := c.GetAllRatings(); got != nil {
+		t.Errorf("GetAllRatings() after RemoveDataPoint = %v, want nil", got)
+	}
+
+	if got := c.Summary(); got != "Not Classified yet" {
+		t.Errorf("Summary() after RemoveDataPoint = %q, want not classified", got)
+	}
+
+	if got := len(c.data); got != 3 {
+		t.Errorf("len(data) after removing 2 of 5 N = %d, want 3", got)
+	}
+}
+
+func TestClassifierTrimOutliers(t *testing.T) {
+	// Twelve steady timings per N, with one wild one at N=200.
+	steady := func(n int) []float64 {
+		vals := make([]float64, 12)
+		for i := range vals {
+			vals[i] = float64(n) + float64(i%3)
+		}
+
+		return vals
+	}
+
+	tests := []struct {
+		name      string
+		threshold float64
+		want      int
+	}{
+		{name: "typical threshold", threshold: 3, want: 1},
+		{name: "threshold above any z-score", threshold: 10, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			for n := 100; n <= 500; n += 100 {
+				_ = c.AddDataPoint(n, steady(n)...)
+			}
+
+			_ = c.AddDataPoint(200, 100000)
+			_ = c.AddDataPoint(600, 600)
+			_ = c.AddDataPoint(700, 700, 700, 700)
+
+			if _, err := c.Classify(); err != nil {
+				t.Fatalf("Classify() returned error: %v", err)
+			}
+
+			if got := c.TrimOutliers(tt.threshold); got != tt.want {
+				t.Errorf("TrimOutliers(%v) = %d, want %d", tt.threshold, got, tt.want)
+			}
+
+			wantLen := 13 - tt.want
+			if got := len(c.data[200]); got != wantLen {
+				t.Errorf("after TrimOutliers(%v) N=200 has %d values, want %d", tt.threshold, got, wantLen)
+			}
+
+			if slices.Contains(c.data[200], 100000) == (tt.want > 0) {
+				t.Errorf("after TrimOutliers(%v) N=200 values = %v", tt.threshold, c.data[200])
+			}
+
+			if len(c.data[600]) != 1 || len(c.data[700]) != 3 {
+				t.Errorf("TrimOutliers(%v) changed an N with a single or constant value", tt.threshold)
+			}
+
+			if c.classified != (tt.want == 0) {
+				t.Errorf("after TrimOutliers(%v) classified = %v, want %v", tt.threshold, c.classified, tt.want == 0)
+			}
+		})
+	}
+}
+
+func TestClassifierTrimOutliersKeepsWeights(t *testing.T) {
+	c := NewClassifier()
+	vals := []float64{10, 11, 10, 11, 10, 11, 10, 11, 10, 11, 10, 11, 1000}
+	if err := c.AddWeightedDataPoint(100, 0.5, vals...); err != nil {
+		t.Fatalf("AddWeightedDataPoint() returned error: %v", err)
+	}
+
+	if got := c.TrimOutliers(3); got != 1 {
+		t.Fatalf("TrimOutliers(3) = %d, want 1", got)
+	}
+
+	if weights, _ := c.weightsFor([]int{100}); weights[0] != 0.5 {
+		t.Errorf("weight at N=100 after TrimOutliers = %v, want 0.5", weights[0])
+	}
+}
+
+func TestClassifierScoreMap(t *testing.T) {
+	c := NewClassifier()
+	if got := c.ScoreMap(); got != nil {
+		t.Errorf("ScoreMap() before Classify() = %v, want nil", got)
+	}
+
+	for n := 100; n <= 1000; n += 100 {
+		_ = c.AddDataPoint(n, float64(n))
+	}
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	want := make(map[string]float64)
+	for _, r := range c.GetAllRatings() {
+		want[r.BigO().Label()] = r.Score()
+	}
+
+	got := c.ScoreMap()
+	if !cmp.Equal(got, want) {
+		t.Errorf("ScoreMap() = %v, want %v", got, want)
+	}
+
+	if got[Linear.Label()] != c.rating.score {
+		t.Errorf("ScoreMap()[%q] = %v, want the winning score %v", Linear.Label(), got[Linear.Label()], c.rating.score)
+	}
+
+	c.Reset()
+	if got := c.ScoreMap(); got != nil {
+		t.Errorf("ScoreMap() after Reset() = %v, want nil", got)
+	}
+}
+
+func TestClassifierAggregatedValues(t *testing.T) {
+	tests := []struct {
+		mode     AggregationMode
+		wantNs   []int
+		wantVals []float64
+	}{
+		{mode: AggregationMean, wantNs: []int{10, 20}, wantVals: []float64{4, 20}},
+		{mode: AggregationRaw, wantNs: []int{10, 10, 10, 20}, wantVals: []float64{9, 1, 2, 20}},
+		{mode: AggregationMedian, wantNs: []int{10, 20}, wantVals: []float64{2, 20}},
+		{mode: AggregationMin, wantNs: []int{10, 20}, wantVals: []float64{1, 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			c := NewClassifier()
+			_ = c.AddDataPoint(10, 9, 1, 2)
+			_ = c.AddDataPoint(20, 20)
+			c.SetAggregation(tt.mode)
+
+			Ns, vals := c.aggregatedValues()
+			if !cmp.Equal(Ns, tt.wantNs) || !cmp.Equal(vals, tt.wantVals) {
+				t.Errorf("aggregatedValues() = %v, %v, want %v, %v", Ns, vals, tt.wantNs, tt.wantVals)
+			}
+		})
+	}
+}
+
+func TestClassifierSetAggregation(t *testing.T) {
+	// Linear timings where every N has one run hit by heavy noise.
+	c := NewClassifier()
+	for n := 100; n <= 1000; n += 100 {
+		noise := float64(n%300) * 50
+		_ = c.AddDataPoint(n, float64(n), float64(n)+1, float64(n)+noise)
+	}
+
+	scores := make(map[AggregationMode]float64)
+	for _, mode := range []AggregationMode{AggregationMean, AggregationRaw, AggregationMedian, AggregationMin} {
+		c.SetAggregation(mode)
+		if _, err := c.Classify(); err != nil {
+			t.Fatalf("Classify() with %s returned error: %v", mode, err)
+		}
+
+		scores[mode] = c.ScoreMap()[Linear.Label()]
+	}
+
+	for _, mode := range []AggregationMode{AggregationMedian, AggregationMin} {
+		if scores[mode] < 0.9999 {
+			t.Errorf("%s score for %s = %v, want nearly 1", mode, Linear.Label(), scores[mode])
+		}
+
+		if scores[mode] <= scores[AggregationMean] {
+			t.Errorf("%s score for %s = %v, want more than the %s score %v", mode, Linear.Label(), scores[mode], AggregationMean, scores[AggregationMean])
+		}
+	}
+}
+
+func TestClassifierClassifyNsWithoutValues(t *testing.T) {
+	// Three distinct Ns pass the first data point check, but one of them
+	// has no values and is dropped when the values are aggregated.
+	for _, mode := range []AggregationMode{AggregationMean, AggregationRaw, AggregationMedian, AggregationMin} {
+		t.Run(mode.String(), func(t *testing.T) {
+			c := NewClassifier()
+			c.SetAggregation(mode)
+			_ = c.AddDataPoint(10, 10)
+			_ = c.AddDataPoint(20, 20)
+			_ = c.AddDataPoint(30)
+
+			if _, err := c.Classify(); !errors.Is(err, ErrInsufficientData) {
+				t.Errorf("Classify() error = %v, want %v", err, ErrInsufficientData)
+			}
+
+			// Once enough Ns have values it classifies normally.
+			_ = c.AddDataPoint(30, 30)
+
+			if _, err := c.Classify(); err != nil {
+				t.Errorf("Classify() returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestClassifierClassifyBigNsWithoutValues(t *testing.T) {
+	// Any big.Float data sends Classify down the big.Float path, where an
+	// N with no values would otherwise be averaged as 0/0.
+	c := NewClassifier()
+	_ = c.AddDataPoint(10, 10)
+	_ = c.AddDataPointBig(20, big.NewFloat(20))
+	_ = c.AddDataPointBig(30)
+
+	if _, err := c.Classify(); !errors.Is(err, ErrInsufficientData) {
+		t.Errorf("Classify() error = %v, want %v", err, ErrInsufficientData)
+	}
+}
+
+func TestLoadJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		wantErr bool
+		want    map[int][]float64
+	}{
+		{
+			name:    "valid file (non-positive n filtered out)",
+			file:    "testdata/valid.json",
+			wantErr: false,
+			want: map[int][]float64{
+				100: {1.2, 1.3},
+				200: {2.4},
+				400: {4.9, 4.7, 4.8},
+				800: {9.6},
+			},
+		},
+		{
+			name:    "empty array",
+			file:    "testdata/empty_array.json",
+			wantErr: false,
+			want:    map[int][]float64{},
+		},
+		// Error cases
+		{
+			name:    "file not found",
+			file:    "testdata/nonexistent.json",
+			wantErr: true,
+			want:    map[int][]float64{},
+		},
+		{
+			name:    "malformed JSON",
+			file:    "testdata/error_malformed.json",
+			wantErr: true,
+			want:    map[int][]float64{},
+		},
+		{
+			name:    "invalid n",
+			file:    "testdata/error_invalid_n.json",
+			wantErr: true,
+			want:    map[int][]float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			err := c.LoadJSON(tt.file)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadJSON(%q) error = %v, wantErr %v", tt.file, err, tt.wantErr)
+			}
+
+			if !cmp.Equal(c.data, tt.want) {
+				t.Errorf("LoadJSON(%q) loaded %v, want %v", tt.file, c.data, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadJSONMalformedErrorIsWrapped(t *testing.T) {
+	err := NewClassifier().LoadJSON("testdata/error_malformed.json")
+
+	var syntaxErr *json.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Errorf("LoadJSON() error = %v, want it to wrap a *json.SyntaxError", err)
+	}
+}
+
+func TestLoadJSONEmptyArrayClassify(t *testing.T) {
+	c := NewClassifier()
+	if err := c.LoadJSON("testdata/empty_array.json"); err != nil {
+		t.Fatalf("LoadJSON() returned error: %v", err)
+	}
+
+	if _, err := c.Classify(); !errors.Is(err, ErrInsufficientData) {
+		t.Errorf("Classify() after loading an empty array error = %v, want %v", err, ErrInsufficientData)
+	}
+}
+
+func TestLoadCSVReader(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		header  bool
+		wantErr bool
+		want    map[int][]float64
+	}{
+		{
+			name:    "with header",
+			input:   "n,ns\n100,1.5\n200,3\n100,2.5\n",
+			header:  true,
+			wantErr: false,
+			want:    map[int][]float64{100: {1.5, 2.5}, 200: {3}},
+		},
+		{
+			name:    "non-positive n filtered out",
+			input:   "0,1\n-5,2\n10,3\n",
+			header:  false,
+			wantErr: false,
+			want:    map[int][]float64{10: {3}},
+		},
+		// Error cases
+		{
+			name:    "empty",
+			input:   "",
+			header:  false,
+			wantErr: true,
+			want:    map[int][]float64{},
+		},
+		{
+			name:    "insufficient columns",
+			input:   "10\n20\n",
+			header:  false,
+			wantErr: true,
+			want:    map[int][]float64{},
+		},
+		{
+			name:    "invalid value stops the whole load",
+			input:   "10,1\n20,abc\n",
+			header:  false,
+			wantErr: true,
+			want:    map[int][]float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			err := c.LoadCSVReader(strings.NewReader(tt.input), tt.header, ',')
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadCSVReader() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !cmp.Equal(c.data, tt.want) {
+				t.Errorf("LoadCSVReader() loaded %v, want %v", c.data, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadCSVReaderMatchesLoadCSV(t *testing.T) {
+	files, err := filepath.Glob("testdata/example_*.csv")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("Glob() found no sample files: %v", err)
+	}
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			fromFile := NewClassifier()
+			if err := fromFile.LoadCSV(file, true, ','); err != nil {
+				t.Fatalf("LoadCSV(%q) returned error: %v", file, err)
+			}
+
+			contents, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("ReadFile(%q) returned error: %v", file, err)
+			}
+
+			fromReader := NewClassifier()
+			if err := fromReader.LoadCSVReader(bytes.NewReader(contents), true, ','); err != nil {
+				t.Fatalf("LoadCSVReader() returned error: %v", err)
+			}
+
+			if !cmp.Equal(fromReader.data, fromFile.data) {
+				t.Errorf("LoadCSVReader() loaded different data than LoadCSV(%q)", file)
+			}
+		})
+	}
+}
+
+func TestLoadCSVAllValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		allValues bool
+		wantErr   bool
+		want      map[int][]float64
+	}{
+		{
+			name:      "every column read",
+			input:     "100,1,2,3\n200,4,5,6\n",
+			allValues: true,
+			wantErr:   false,
+			want:      map[int][]float64{100: {1, 2, 3}, 200: {4, 5, 6}},
+		},
+		{
+			name:      "rows with differing column counts",
+			input:     "100,1,2,3\n200,4\n100,7\n",
+			allValues: true,
+			wantErr:   false,
+			want:      map[int][]float64{100: {1, 2, 3, 7}, 200: {4}},
+		},
+		{
+			name:      "extra columns ignored by default",
+			input:     "100,1,2,3\n200,4,5,6\n",
+			allValues: false,
+			wantErr:   false,
+			want:      map[int][]float64{100: {1}, 200: {4}},
+		},
+		{
+			name:      "non-numeric trailing column ignored by default",
+			input:     "100,1,fast\n200,4,slow\n",
+			allValues: false,
+			wantErr:   false,
+			want:      map[int][]float64{100: {1}, 200: {4}},
+		},
+		// Error cases
+		{
+			name:      "non-numeric trailing column",
+			input:     "100,1,fast\n200,4,slow\n",
+			allValues: true,
+			wantErr:   true,
+			want:      map[int][]float64{},
+		},
+		{
+			name:      "empty trailing column",
+			input:     "100,1,2,\n",
+			allValues: true,
+			wantErr:   true,
+			want:      map[int][]float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			c.SetCSVAllValues(tt.allValues)
+
+			err := c.LoadCSVReader(strings.NewReader(tt.input), false, ',')
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadCSVReader() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !cmp.Equal(c.data, tt.want) {
+				t.Errorf("LoadCSVReader() loaded %v, want %v", c.data, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadCSVAllValuesFile(t *testing.T) {
+	c := NewClassifier()
+	c.SetCSVAllValues(true)
+	if err := c.LoadCSV("testdata/valid_all_values.csv", true, ','); err != nil {
+		t.Fatalf("LoadCSV() returned error: %v", err)
+	}
+
+	want := map[int][]float64{
+		100: {1.2, 1.3, 1.25, 1.22, 1.27},
+		200: {2.4, 2.5, 2.45, 2.41, 2.48},
+		400: {4.9, 4.8},
+	}
+
+	if !cmp.Equal(c.data, want) {
+		t.Errorf("LoadCSV() loaded %v, want %v", c.data, want)
+	}
+}
+
+func TestClassifierWriteReportCSV(t *testing.T) {
+	c := NewClassifier()
+
+	var buf bytes.Buffer
+	if err := c.WriteReportCSV(&buf); !errors.Is(err, ErrNotClassified) {
+		t.Errorf("WriteReportCSV() before Classify() error = %v, want %v", err, ErrNotClassified)
+	}
+
+	for n := 100; n <= 1000; n += 100 {
+		_ = c.AddDataPoint(n, float64(n))
+	}
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	buf.Reset()
+	if err := c.WriteReportCSV(&buf); err != nil {
+		t.Fatalf("WriteReportCSV() returned error: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("reading the report back returned error: %v", err)
+	}
+
+	ratings := c.GetAllRatings()
+	if len(records) != len(ratings)+1 {
+		t.Fatalf("WriteReportCSV() wrote %d rows, want a header and %d ratings", len(records), len(ratings))
+	}
+
+	if want := []string{"label", "rank", "score"}; !cmp.Equal(records[0], want) {
+		t.Errorf("WriteReportCSV() header = %v, want %v", records[0], want)
+	}
+
+	for i, r := range ratings {
+		score, err := strconv.ParseFloat(records[i+1][2], 64)
+		if err != nil {
+			t.Fatalf("WriteReportCSV() row %d score %q does not parse: %v", i+1, records[i+1][2], err)
+		}
+
+		got := []string{records[i+1][0], records[i+1][1]}
+		want := []string{r.BigO().Label(), strconv.Itoa(r.BigO().rank)}
+		if !cmp.Equal(got, want) || score != r.Score() {
+			t.Errorf("WriteReportCSV() row %d = %v, want %v with score %v", i+1, records[i+1], want, r.Score())
+		}
+	}
+}
+
+func TestClassifierLoadGoBenchmark(t *testing.T) {
+	const output = `goos: linux
+goarch: amd64
+pkg: github.com/rsned/bigo/examples
+cpu: Some CPU @ 2.00GHz
+BenchmarkSort/n=100-8         	  500000	       97.3 ns/op
+BenchmarkSort/n=200-8         	  250000	      195.1 ns/op	      16 B/op	       1 allocs/op
+BenchmarkSort/n=100-8         	  500000	       99.1 ns/op
+BenchmarkSort/n=0-8           	  500000	        1.0 ns/op
+BenchmarkSort/small-8         	  500000	       10.0 ns/op
+BenchmarkSearch/n=100-8       	 9000000	        3.2 ns/op
+BenchmarkAll/Linear_Sum_n=400-8	   90000	      401.5 ns/op
+--- BENCH: BenchmarkSort
+    some log output n=5
+PASS
+ok  	github.com/rsned/bigo/examples	12.345s
+`
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    map[int][]float64
+	}{
+		{
+			name:    "matching subset",
+			pattern: "^BenchmarkSort/",
+			want:    map[int][]float64{100: {97.3, 99.1}, 200: {195.1}},
+		},
+		{
+			name:    "name with an underscore before n=",
+			pattern: "Linear_Sum",
+			want:    map[int][]float64{400: {401.5}},
+		},
+		{
+			name:    "everything",
+			pattern: "",
+			want:    map[int][]float64{100: {97.3, 99.1, 3.2}, 200: {195.1}, 400: {401.5}},
+		},
+		{
+			name:    "nothing matches",
+			pattern: "BenchmarkMissing",
+			want:    map[int][]float64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			if err := c.LoadGoBenchmark(strings.NewReader(output), tt.pattern); err != nil {
+				t.Fatalf("LoadGoBenchmark(%q) returned error: %v", tt.pattern, err)
+			}
+
+			if !cmp.Equal(c.data, tt.want) {
+				t.Errorf("LoadGoBenchmark(%q) loaded %v, want %v", tt.pattern, c.data, tt.want)
+			}
+		})
+	}
+
+	if err := NewClassifier().LoadGoBenchmark(strings.NewReader(output), "Sort("); err == nil {
+		t.Errorf("LoadGoBenchmark() with an invalid pattern should have returned an error")
+	}
+}
+
+func TestClassifierDetailedSummary(t *testing.T) {
+	c := NewClassifier()
+	if got := c.DetailedSummary(); got != "Not Classified yet" {
+		t.Errorf("DetailedSummary() before Classify() = %q, want not classified", got)
+	}
+
+	for n := 100; n <= 500; n += 100 {
+		_ = c.AddDataPoint(n, float64(3*n*n+7))
+	}
+
+	if _, err := c.Classify(); err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	got := c.DetailedSummary()
+	if !strings.HasPrefix(got, c.Summary()) {
+		t.Errorf("DetailedSummary() does not start with Summary()")
+	}
+
+	for _, r := range c.GetAllRatings() {
+		header := fmt.Sprintf("\n%s:   score %0.8f", r.BigO().Label(), r.Score())
+		if !strings.Contains(got, header) {
+			t.Errorf("DetailedSummary() is missing the section %q", header)
+		}
+	}
+
+	// The quadratic fit is exact, so its predictions match the actual values.
+	section := got[strings.Index(got, "\nO(n^2):   score"):]
+	section = section[:strings.Index(section, "\nO(n^3):")]
+	for n := 100; n <= 500; n += 100 {
+		row := fmt.Sprintf("  %12d  %16.6g  %16.6g\n", n, float64(3*n*n+7), float64(3*n*n+7))
+		if !strings.Contains(section, row) {
+			t.Errorf("DetailedSummary() %s section = %q, want it to contain %q", Quadratic.Label(), section, row)
+		}
+	}
+
+	// Factorial overflows float64 well before N=500.
+	if !strings.Contains(got, Factorial.Label()+":   score") || !strings.Contains(got, "model exceeds the float64 range") {
+		t.Errorf("DetailedSummary() does not note the models that exceed float64")
+	}
+}
+
+func TestClassifierIncludeInverseAckermann(t *testing.T) {
+	// N values spanning every step of α(n) a union-find can reach.
+	ns := []int{2, 4, 6, 8, 16, 64, 256, 1024, 2048, 4096, 8192}
+
+	tests := []struct {
+		name    string
+		ns      []int
+		f       func(n int) float64
+		include bool
+		want    *BigO
+	}{
+		{
+			name:    "union-find timings",
+			ns:      ns,
+			f:       func(n int) float64 { return 40 + 25*inverseAckermann(n) + math.Sin(float64(n)) },
+			include: true,
+			want:    InverseAckerman,
+		},
+		{
+			name:    "constant timings",
+			ns:      ns,
+			f:       func(n int) float64 { return 100 + math.Sin(float64(n)) },
+			include: true,
+			want:    Constant,
+		},
+		{
+			name:    "union-find timings when not included",
+			ns:      ns,
+			f:       func(n int) float64 { return 40 + 25*inverseAckermann(n) + math.Sin(float64(n)) },
+			include: false,
+			want:    nil,
+		},
+		{
+			// α(n) is 3 throughout, so it is not rated at all.
+			name:    "constant timings where α(n) is flat",
+			ns:      []int{100, 200, 300, 400, 500, 600},
+			f:       func(n int) float64 { return 100 + math.Sin(float64(n)) },
+			include: true,
+			want:    Constant,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClassifier()
+			c.IncludeInverseAckermann(tt.include)
+			for _, n := range tt.ns {
+				_ = c.AddDataPoint(n, tt.f(n))
+			}
+
+			got, err := c.Classify()
+			if err != nil {
+				t.Fatalf("Classify() returned error: %v", err)
+			}
+
+			rated := slices.ContainsFunc(c.GetAllRatings(), func(r *Rating) bool { return r.BigO() == InverseAckerman })
+			if rated != (tt.include && modelVaries(InverseAckerman, tt.ns)) {
+				t.Errorf("Classify() rated %s = %v, want %v", InverseAckerman.Label(), rated, !rated)
+			}
+
+			if tt.want == nil {
+				if got.BigO() == InverseAckerman {
+					t.Errorf("Classify() = %s without IncludeInverseAckermann", got.BigO().Label())
+				}
+
+				return
+			}
+
+			if got.BigO() != tt.want {
+				t.Errorf("Classify() = %s, want %s", got.BigO().Label(), tt.want.Label())
+			}
+		})
+	}
+
+	if InverseAckerman.active || slices.Contains(BigOOrdered, InverseAckerman) {
+		t.Errorf("IncludeInverseAckermann changed the global set of BigOs")
+	}
+}
+
+func TestClassifierRegisterBigO(t *testing.T) {
+	karatsuba, err := NewPolynomialBigO(math.Log2(3))
+	if err != nil {
+		t.Fatalf("NewPolynomialBigO(log2(3)) returned error: %v", err)
+	}
+
+	c := NewClassifier()
+	if err := c.RegisterBigO(karatsuba); err != nil {
+		t.Fatalf("RegisterBigO(%s) returned error: %v", karatsuba.Label(), err)
+	}
+
+	other := NewClassifier()
+	for n := 10; n <= 200; n += 10 {
+		v := math.Pow(float64(n), math.Log2(3))
+		_ = c.AddDataPoint(n, v)
+		_ = other.AddDataPoint(n, v)
+	}
+
+	got, err := c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	if got.BigO() != karatsuba {
+		t.Errorf("Classify() = %s, want %s", got.BigO().Label(), karatsuba.Label())
+	}
+
+	clone := c.Clone()
+	if got, err := clone.Classify(); err != nil || got.BigO() != karatsuba {
+		t.Errorf("Clone().Classify() = %v, %v, want %s", got, err, karatsuba.Label())
+	}
+
+	got, err = other.Classify()
+	if err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	if got.BigO() == karatsuba {
+		t.Errorf("Classify() on another Classifier = %s, want a built-in class", got.BigO().Label())
+	}
+
+	if slices.Contains(BigOOrdered, karatsuba) {
+		t.Errorf("Classifier.RegisterBigO changed the global set of BigOs")
+	}
+
+	tests := []struct {
+		name string
+		o    *BigO
+	}{
+		{
+			name: "nil BigO",
+			o:    nil,
+		},
+		{
+			name: "already registered",
+			o:    karatsuba,
+		},
+		{
+			name: "rank collides with built-in",
+			o:    newBigO(Linear.rank, "O(n)'", "collides with linear", func(x float64) float64 { return x }),
+		},
+		{
+			name: "nil model functions",
+			o:    newBigO(12345, "O(?)'", "missing model", nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := c.RegisterBigO(tt.o); err == nil {
+				t.Errorf("RegisterBigO() expected an error, got none")
+			}
+		})
+	}
+}
+
+func TestAddBenchmarkResultMemory(t *testing.T) {
+	tests := []struct {
+		name       string
+		result     testing.BenchmarkResult
+		wantBytes  float64
+		wantAllocs float64
+		wantPoints int
+	}{
+		{
+			name: "whole bytes per op",
+			result: testing.BenchmarkResult{
+				N:         100,
+				T:         time.Millisecond,
+				Bytes:     0,
+				MemAllocs: 300,
+				MemBytes:  6400,
+				Extra:     nil,
+			},
+			wantBytes:  64,
+			wantAllocs: 3,
+			wantPoints: 1,
+		},
+		{
+			name: "fractional values are not truncated",
+			result: testing.BenchmarkResult{
+				N:         4,
+				T:         time.Millisecond,
+				Bytes:     0,
+				MemAllocs: 1,
+				MemBytes:  10,
+				Extra:     nil,
+			},
+			wantBytes:  2.5,
+			wantAllocs: 0.25,
+			wantPoints: 1,
+		},
+		{
+			name: "no allocations",
+			result: testing.BenchmarkResult{
+				N:         1000,
+				T:         time.Millisecond,
+				Bytes:     0,
+				MemAllocs: 0,
+				MemBytes:  0,
+				Extra:     nil,
+			},
+			wantBytes:  0,
+			wantAllocs: 0,
+			wantPoints: 1,
+		},
+		{
+			name: "zero iterations",
+			result: testing.BenchmarkResult{
+				N:         0,
+				T:         time.Millisecond,
+				Bytes:     0,
+				MemAllocs: 10,
+				MemBytes:  100,
+				Extra:     nil,
+			},
+			wantBytes:  0,
+			wantAllocs: 0,
+			wantPoints: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, m := range []struct {
+				name string
+				add  func(*Classifier, testing.BenchmarkResult) error
+				want float64
+			}{
+				{"AddBenchmarkResultBytes", (*Classifier).AddBenchmarkResultBytes, tt.wantBytes},
+				{"AddBenchmarkResultAllocs", (*Classifier).AddBenchmarkResultAllocs, tt.wantAllocs},
+			} {
+				c := NewClassifier()
+				if err := m.add(c, tt.result); err != nil {
+					t.Fatalf("%s() returned error: %v", m.name, err)
+				}
+
+				if len(c.data) != tt.wantPoints {
+					t.Fatalf("%s() added %d data points, want %d", m.name, len(c.data), tt.wantPoints)
+				}
+
+				if tt.wantPoints == 0 {
+					continue
+				}
+
+				if got := c.data[tt.result.N]; !slices.Equal(got, []float64{m.want}) {
+					t.Errorf("%s() values for N=%d = %v, want [%v]", m.name, tt.result.N, got, m.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAddBenchmarkResultBytesClassify(t *testing.T) {
+	c := NewClassifier()
+	for n := 1000; n <= 20000; n += 1000 {
+		// A function that allocates a slice of n ints on each call.
+		result := testing.BenchmarkResult{
+			N:         n,
+			T:         time.Duration(n) * time.Microsecond,
+			Bytes:     0,
+			MemAllocs: uint64(n),
+			MemBytes:  uint64(n) * uint64(8*n+24),
+			Extra:     nil,
+		}
+		if err := c.AddBenchmarkResultBytes(result); err != nil {
+			t.Fatalf("AddBenchmarkResultBytes() returned error: %v", err)
+		}
+	}
+
+	got, err := c.Classify()
+	if err != nil {
+		t.Fatalf("Classify() returned error: %v", err)
+	}
+
+	if got.BigO() != Linear {
+		t.Errorf("Classify() = %s, want %s", got.BigO().Label(), Linear.Label())
+	}
+}
+
+func TestClassifierRunAndClassify(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping benchmark sweep in short mode")
+	}
+
+	sizes := []int{1000, 4000, 16000}
+
+	var sink int
+	c := NewClassifier()
+	got, err := c.RunAndClassify(sizes, func(n int) func(b *testing.B) {
+		vals := make([]int, n)
+		for i := range vals {
+			vals[i] = i
+		}
+
+		return func(b *testing.B) {
+			for b.Loop() {
+				for _, v := range vals {
+					sink += v
+				}
+			}
+		}
+	})
+	if err != nil {
+		t.Fatalf("RunAndClassify() returned error: %v", err)
+	}
+
+	if got.BigO() == Unrated {
+		t.Errorf("RunAndClassify() = %s, want a rated class", got.BigO().Label())
+	}
+
+	for _, n := range sizes {
+		if vals := c.data[n]; len(vals) != 1 || vals[0] <= 0 {
+			t.Errorf("RunAndClassify() recorded %v for N=%d, want one positive ns/op", vals, n)
+		}
+	}
+}
+
+func TestClassifierRunAndClassifyErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(n int) func(b *testing.B)
+	}{
+		{
+			name: "nil function",
+			fn:   nil,
+		},
+		{
+			name: "skipped benchmark",
+			fn: func(_ int) func(b *testing.B) {
+				return func(b *testing.B) { b.Skip("not run") }
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewClassifier().RunAndClassify([]int{10, 20, 30}, tt.fn); err == nil {
+				t.Errorf("RunAndClassify() expected an error, got none")
+			}
+		})
+	}
+}
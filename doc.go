@@ -25,6 +25,7 @@ The library has support for the following Big O classifications:
   - O(n (log n)) - Log Time
   - O((log n)^c) - Polylogarithmic Time
   - O(n) - Linear Time
+  - O(n log log n) - N Log Log Time
   - O(n log* n) - N Log* Time
   - O(n log n) - Linearithmic Time
   - O(n^2) - Quadratic Time
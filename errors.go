@@ -0,0 +1,37 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigo
+
+import "errors"
+
+// Sentinel errors returned (wrapped with additional detail) by this package.
+// Use errors.Is to check for them.
+var (
+	// ErrInsufficientData is returned when there are too few data points
+	// to perform the requested analysis.
+	ErrInsufficientData = errors.New("insufficient data")
+
+	// ErrLengthMismatch is returned when paired slices of sizes and values
+	// do not have the same length.
+	ErrLengthMismatch = errors.New("length mismatch")
+
+	// ErrNotClassified is returned by methods that require Classify to have
+	// been called first.
+	ErrNotClassified = errors.New("not classified")
+
+	// ErrBigFloatUnsupported is returned when an operation does not yet
+	// handle big.Float data.
+	ErrBigFloatUnsupported = errors.New("big.Float data unsupported")
+)
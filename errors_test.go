@@ -0,0 +1,110 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigo
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	twoPoints := NewClassifier()
+	_ = twoPoints.AddDataPoint(1, 1)
+	_ = twoPoints.AddDataPoint(2, 2)
+
+	bigData := NewClassifier()
+	for _, n := range []int{1, 2, 3} {
+		_ = bigData.AddDataPoint(n, float64(n))
+		_ = bigData.AddDataPointBig(n, big.NewFloat(float64(n)))
+	}
+
+	tests := []struct {
+		name string
+		fn   func() error
+		want error
+	}{
+		{
+			name: "Rate with 2 points",
+			fn: func() error {
+				_, err := Linear.Rate([]int{1, 2}, []float64{1, 2})
+
+				return err
+			},
+			want: ErrInsufficientData,
+		},
+		{
+			name: "RateBig with 2 points",
+			fn: func() error {
+				_, err := Linear.RateBig([]int{1, 2}, []*big.Float{big.NewFloat(1), big.NewFloat(2)})
+
+				return err
+			},
+			want: ErrInsufficientData,
+		},
+		{
+			name: "Classify with 2 points",
+			fn: func() error {
+				_, err := twoPoints.Classify()
+
+				return err
+			},
+			want: ErrInsufficientData,
+		},
+		{
+			name: "Rate with mismatched lengths",
+			fn: func() error {
+				_, err := Linear.Rate([]int{1, 2, 3}, []float64{1, 2})
+
+				return err
+			},
+			want: ErrLengthMismatch,
+		},
+		{
+			name: "AddDataPoints with mismatched lengths",
+			fn: func() error {
+				return NewClassifier().AddDataPoints([]int{1, 2}, [][]float64{{1}})
+			},
+			want: ErrLengthMismatch,
+		},
+		{
+			name: "DisambiguateFlat before Classify",
+			fn: func() error {
+				_, _, err := NewClassifier().DisambiguateFlat()
+
+				return err
+			},
+			want: ErrNotClassified,
+		},
+		{
+			name: "PairwiseGrowthRatios with big.Float data",
+			fn: func() error {
+				_, _, err := bigData.PairwiseGrowthRatios()
+
+				return err
+			},
+			want: ErrBigFloatUnsupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.fn()
+			if !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want errors.Is(err, %v)", err, tt.want)
+			}
+		})
+	}
+}
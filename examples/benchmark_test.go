@@ -15,19 +15,33 @@
 package examples
 
 import (
+	"encoding/csv"
 	"flag"
 	"fmt"
 	"math"
+	"math/bits"
 	"math/rand"
+	"os"
+	"path/filepath"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/rsned/bigo"
 	"github.com/rsned/bigo/examples/constant"
+	"github.com/rsned/bigo/examples/cubic"
 	"github.com/rsned/bigo/examples/datatypes/collection"
 	"github.com/rsned/bigo/examples/datatypes/tree"
+	"github.com/rsned/bigo/examples/factorial"
+	"github.com/rsned/bigo/examples/inverseackermann"
 	"github.com/rsned/bigo/examples/linear"
+	"github.com/rsned/bigo/examples/logarithmic"
 	"github.com/rsned/bigo/examples/loglog"
+	"github.com/rsned/bigo/examples/nlogstar"
+	"github.com/rsned/bigo/examples/polylogarithmic"
+	"github.com/rsned/bigo/examples/quadratic"
 )
 
 // These values are small enough for everything below exponential to run if not overridden.
@@ -40,6 +54,9 @@ const (
 // benchmarkExampleName is the name of the example method to benchmark.
 var benchmarkExampleName = flag.String("benchmark_example_name", "", "Name of the example method to benchmark. See the code for the available options.")
 
+// benchmarkCategory limits BenchmarkAllBigOExamples to one complexity class.
+var benchmarkCategory = flag.String("benchmark_category", "", "Only run the example methods whose name has this category prefix, e.g. Linearithmic_")
+
 // benchmarkStart is the starting value for benchmark iterations
 var benchmarkStart = flag.Int("benchmark_start", benchmarkStartDefault, "Starting value for benchmark iterations")
 
@@ -49,6 +66,19 @@ var benchmarkEnd = flag.Int("benchmark_end", benchmarkEndDefault, "Ending value
 // benchmarkStep is the step size for benchmark iterations
 var benchmarkStep = flag.Int("benchmark_step", benchmarkStepDefault, "Step size for benchmark iterations")
 
+// benchmarkStepMode is how N advances between benchmark iterations.
+var benchmarkStepMode = flag.String("benchmark_step_mode", "", "How N advances between benchmark iterations: linear (add step) or geometric (multiply by step)")
+
+// benchmarkCSVOut is the file to write (n, ns/op) rows to as benchmarks run.
+// Each benchmark and case gets its own file; see csvOutPath.
+var benchmarkCSVOut = flag.String("benchmark_csv_out", "", "File to write n,ns/op rows to as the benchmarks run, in the format Classifier.LoadCSV reads. The benchmark name is added before the extension, giving one file per benchmark and case")
+
+// csvOutStarted records the -benchmark_csv_out files created so far in this
+// run. The first run of a benchmark replaces any existing file, and a later
+// run of the same benchmark in the process, such as from both
+// BenchmarkExampleMethod and BenchmarkAllBigOExamples, appends to it.
+var csvOutStarted = make(map[string]bool)
+
 // To cut out some of the timing variability of benchmark functions, pre-create
 // and sort a large set of random values.
 var (
@@ -71,12 +101,40 @@ var (
 
 		// Logarthmic benchmark variables
 		bmLogarithmicBST *logarithmic.TreeNode
-
-		// Polylogarithmic benchmark variables
-		bmPolylogarithmicRangeTree *polylogarithmic.RangeTree2D
 	*/
+	// Inverse Ackermann benchmark variables
+	bmInverseAckermannUnionFind *inverseackermann.UnionFind
+	bmInverseAckermannQuery     int
+
+	// Log-log benchmark variables
+	bmLogLogVEBTree *loglog.VEBTree
+
+	// Logarithmic benchmark variables
+	bmLogarithmicFenwickTree *tree.FenwickTree
+	bmLogarithmicSegmentTree *logarithmic.SegmentTree
+
+	// Polylogarithmic benchmark variables
+	bmPolylogarithmicRangeTree        *polylogarithmic.RangeTree2D
+	bmPolylogarithmicCascade          *polylogarithmic.FractionalCascade
+	bmPolylogarithmicCascadeListCount int
+
 	// Linear benchmark variables
-	bmLinearBST *tree.BSTNode
+	bmLinearBST                 *tree.BSTNode
+	bmLinearBucketSortValues    []float64
+	bmLinearCountingSortValues  []int
+	bmLinearCountingSortBounded []int
+	bmLinearRadixSortValues     []int
+	bmLinearPalindrome          string
+	bmLinearRotateValues        []int
+
+	// Quadratic benchmark variables
+	bmQuadraticPalindrome string
+
+	// Cubic benchmark variables
+	bmCubicNaiveMatrixA                  [][]int
+	bmCubicNaiveMatrixB                  [][]int
+	bmCubicStandardMatrixMultiplicationA [][]int
+	bmCubicStandardMatrixMultiplicationB [][]int
 	/*
 		// NLog*N benchmark variables
 
@@ -92,8 +150,6 @@ var (
 		bmQuadraticBubbleSort      []int
 		bmQuadraticInsertionSort   []int
 		bmQuadraticSelectionSort   []int
-		bmQuadraticNaiveMatrixA    [][]int
-		bmQuadraticNaiveMatrixB    [][]int
 		bmQuadraticMatrixTranspose [][]int
 
 		// Cubic benchmark variables
@@ -101,8 +157,6 @@ var (
 		bmCubicMatrixChainMultiplication     []int
 		bmCubicOptimalBSTKeys                []int
 		bmCubicOptimalBSTFreq                []int
-		bmCubicStandardMatrixMultiplicationA [][]int
-		bmCubicStandardMatrixMultiplicationB [][]int
 
 		// Polynomial benchmark variables
 		bmPolynomialEditDistanceS1             string
@@ -159,6 +213,11 @@ type BenchmarkSettings struct {
 	End          int
 	Step         int
 
+	// StepMode is how N advances from Start to End. In StepLinear mode the
+	// Step is added to N each iteration, and in StepGeometric mode N is
+	// multiplied by the Step, giving sizes evenly spaced on a log scale.
+	StepMode StepMode
+
 	// Setup is a function that is called before the main benchmark loops
 	// starts running. Use this to build trees, load hash maps, reset or init
 	// any other data structures that need to be created before the benchmark.
@@ -175,12 +234,118 @@ type BenchmarkSettings struct {
 	// StopTimer/StartTimer/ResetTimer as the benchmark runner handles that.
 	Cleanup func(b *testing.B)
 
-	// TODO(rsned): Add Best/Avg/Worst case identifiers to enable testing the
-	// given benchmark with different levels.  E.g., Searching a Slice for a
-	// non-existent value will be Worst case because it must do a complete scan
-	// of the entire slice of N elements. Binary Searching for the median value
-	// in the input set is going to end up closer to O(1) because it's getting
-	// lucky and finding the value on the first lookup.
+	// Case selects which of the best, average, and worst case inputs to run
+	// the benchmark with. Each selected case runs as its own set of
+	// subtests, labeled with the case, so each can be classified separately.
+	// E.g., Searching a Slice for a non-existent value will be Worst case
+	// because it must do a complete scan of the entire slice of N elements,
+	// while finding it in the first slot is Best case and closer to O(1).
+	//
+	// If no case is set, the benchmark runs once, unlabeled, with the
+	// values as given.
+	Case BenchmarkCase
+
+	// CaseInputs returns the values to give the Runner for the given case
+	// and N. It is called before each set of benchmark loops, outside of
+	// the timer. If nil, every case uses the values as given.
+	CaseInputs func(c BenchmarkCase, n int, vals []int) []int
+}
+
+// StepMode is how a benchmark advances N between iterations.
+type StepMode int
+
+// The ways N can advance between benchmark iterations.
+const (
+	// StepLinear adds the step to N each iteration.
+	StepLinear StepMode = iota
+	// StepGeometric multiplies N by the step each iteration.
+	StepGeometric
+)
+
+// String returns the flag value for this mode.
+func (m StepMode) String() string {
+	switch m {
+	case StepLinear:
+		return "linear"
+	case StepGeometric:
+		return "geometric"
+	default:
+		return fmt.Sprintf("StepMode(%d)", int(m))
+	}
+}
+
+// parseStepMode returns the StepMode named by s, as given to the
+// -benchmark_step_mode flag.
+func parseStepMode(s string) (StepMode, error) {
+	switch s {
+	case "linear":
+		return StepLinear, nil
+	case "geometric":
+		return StepGeometric, nil
+	default:
+		return StepLinear, fmt.Errorf("unknown step mode %q", s)
+	}
+}
+
+// nextN returns the N following n when stepping by step in the given mode.
+func nextN(n, step int, mode StepMode) int {
+	if mode == StepGeometric {
+		return n * step
+	}
+
+	return n + step
+}
+
+// BenchmarkCase identifies a best, average, or worst case input regime for a
+// benchmark. The values are bits so several can be selected at once.
+type BenchmarkCase int
+
+// The input regimes a benchmark can be run under.
+const (
+	CaseBest BenchmarkCase = 1 << iota
+	CaseAverage
+	CaseWorst
+
+	// CaseAll selects all three regimes.
+	CaseAll = CaseBest | CaseAverage | CaseWorst
+)
+
+// benchmarkCases lists the single cases in the order they are run.
+var benchmarkCases = []BenchmarkCase{CaseBest, CaseAverage, CaseWorst}
+
+// String returns the label used for this case in subtest names.
+func (c BenchmarkCase) String() string {
+	switch c {
+	case CaseBest:
+		return "Best"
+	case CaseAverage:
+		return "Average"
+	case CaseWorst:
+		return "Worst"
+	default:
+		return fmt.Sprintf("BenchmarkCase(%d)", int(c))
+	}
+}
+
+// searchCaseTarget is the value the by-case search benchmarks look for. It
+// can't collide with the pre-generated values, which are non-negative.
+const searchCaseTarget = -1
+
+// searchCaseInputs returns a copy of vals[:n] with searchCaseTarget placed
+// first for the best case, in the middle for the average case, and left out
+// entirely for the worst case.
+func searchCaseInputs(c BenchmarkCase, n int, vals []int) []int {
+	inputs := make([]int, n)
+	copy(inputs, vals[:n])
+
+	switch c {
+	case CaseBest:
+		inputs[0] = searchCaseTarget
+	case CaseAverage:
+		inputs[n/2] = searchCaseTarget
+	}
+
+	return inputs
 }
 
 var (
@@ -193,8 +358,11 @@ var (
 			Start:        100000,
 			End:          1000000,
 			Step:         100000,
+			StepMode:     StepLinear,
 			Setup:        nil,
 			Cleanup:      nil,
+			Case:         0,
+			CaseInputs:   nil,
 		},
 		"HashTableLookup": {
 			ExpectedBigO: bigo.Constant,
@@ -203,6 +371,7 @@ var (
 			Start:        100000,
 			End:          1000000,
 			Step:         100000,
+			StepMode:     StepLinear,
 			Setup: func(b *testing.B, n int, vals []int) {
 				b.Helper()
 				// Fill the hash table with the values from the array.
@@ -214,6 +383,8 @@ var (
 			Cleanup: func(_ *testing.B) {
 				bmConstantHashTableLookupMap = nil
 			},
+			Case:       0,
+			CaseInputs: nil,
 		},
 		"BasicMathAdd": {
 			ExpectedBigO: bigo.Constant,
@@ -222,8 +393,11 @@ var (
 			Start:        100000,
 			End:          1000000,
 			Step:         100000,
+			StepMode:     StepLinear,
 			Setup:        nil,
 			Cleanup:      nil,
+			Case:         0,
+			CaseInputs:   nil,
 		},
 		"BasicMathSubtract": {
 			ExpectedBigO: bigo.Constant,
@@ -232,8 +406,11 @@ var (
 			Start:        100000,
 			End:          1000000,
 			Step:         100000,
+			StepMode:     StepLinear,
 			Setup:        nil,
 			Cleanup:      nil,
+			Case:         0,
+			CaseInputs:   nil,
 		},
 		"BasicMathMultiply": {
 			ExpectedBigO: bigo.Constant,
@@ -242,8 +419,11 @@ var (
 			Start:        100000,
 			End:          1000000,
 			Step:         100000,
+			StepMode:     StepLinear,
 			Setup:        nil,
 			Cleanup:      nil,
+			Case:         0,
+			CaseInputs:   nil,
 		},
 		"BasicMathDivide": {
 			ExpectedBigO: bigo.Constant,
@@ -252,8 +432,11 @@ var (
 			Start:        100000,
 			End:          1000000,
 			Step:         100000,
+			StepMode:     StepLinear,
 			Setup:        nil,
 			Cleanup:      nil,
+			Case:         0,
+			CaseInputs:   nil,
 		},
 		"LinkedListAccessFirst": {
 			ExpectedBigO: bigo.Constant,
@@ -262,6 +445,7 @@ var (
 			Start:        100000,
 			End:          1000000,
 			Step:         100000,
+			StepMode:     StepLinear,
 			Setup: func(b *testing.B, n int, vals []int) {
 				b.Helper()
 				bmConstantLinkedList = collection.FromSlice(vals[:n])
@@ -269,6 +453,8 @@ var (
 			Cleanup: func(_ *testing.B) {
 				bmConstantLinkedList = nil
 			},
+			Case:       0,
+			CaseInputs: nil,
 		},
 		"LinkedListAccessLast": {
 			ExpectedBigO: bigo.Constant,
@@ -277,6 +463,7 @@ var (
 			Start:        100000,
 			End:          1000000,
 			Step:         100000,
+			StepMode:     StepLinear,
 			Setup: func(b *testing.B, n int, vals []int) {
 				b.Helper()
 				bmConstantLinkedList = collection.FromSlice(vals[:n])
@@ -284,6 +471,8 @@ var (
 			Cleanup: func(_ *testing.B) {
 				bmConstantLinkedList = nil
 			},
+			Case:       0,
+			CaseInputs: nil,
 		},
 		"StackPush": {
 			ExpectedBigO: bigo.Constant,
@@ -292,6 +481,7 @@ var (
 			Start:        100000,
 			End:          1000000,
 			Step:         100000,
+			StepMode:     StepLinear,
 			Setup: func(b *testing.B, _ int, _ []int) {
 				b.Helper()
 				bmConstantStack = &constant.DynamicStack{}
@@ -299,6 +489,8 @@ var (
 			Cleanup: func(_ *testing.B) {
 				bmConstantStack = nil
 			},
+			Case:       0,
+			CaseInputs: nil,
 		},
 		"StackPop": {
 			ExpectedBigO: bigo.Constant,
@@ -307,6 +499,7 @@ var (
 			Start:        100000,
 			End:          1000000,
 			Step:         100000,
+			StepMode:     StepLinear,
 			Setup: func(b *testing.B, n int, vals []int) {
 				b.Helper()
 				bmConstantStack = &constant.DynamicStack{}
@@ -317,6 +510,8 @@ var (
 			Cleanup: func(_ *testing.B) {
 				bmConstantStack = nil
 			},
+			Case:       0,
+			CaseInputs: nil,
 		},
 		"QueueEnqueue": {
 			ExpectedBigO: bigo.Constant,
@@ -325,6 +520,7 @@ var (
 			Start:        100000,
 			End:          1000000,
 			Step:         100000,
+			StepMode:     StepLinear,
 			Setup: func(b *testing.B, _ int, _ []int) {
 				b.Helper()
 				bmConstantQueue = &constant.Queue{}
@@ -332,6 +528,8 @@ var (
 			Cleanup: func(_ *testing.B) {
 				bmConstantQueue = nil
 			},
+			Case:       0,
+			CaseInputs: nil,
 		},
 		"QueueDequeue": {
 			ExpectedBigO: bigo.Constant,
@@ -340,6 +538,7 @@ var (
 			Start:        100000,
 			End:          1000000,
 			Step:         100000,
+			StepMode:     StepLinear,
 			Setup: func(b *testing.B, n int, vals []int) {
 				b.Helper()
 				bmConstantQueue = &constant.Queue{}
@@ -352,11 +551,96 @@ var (
 			Cleanup: func(_ *testing.B) {
 				bmConstantQueue = nil
 			},
+			Case:       0,
+			CaseInputs: nil,
+		},
+	}
+
+	// inverseAckermannTimeBenchmarks contains O(α(n)) benchmarks
+	inverseAckermannTimeBenchmarks = map[string]BenchmarkSettings{
+		"UnionFindConnected": {
+			ExpectedBigO: bigo.InverseAckerman,
+			Sorted:       false,
+			Runner: func(n int, vals []int) {
+				// Walk through the elements so successive queries follow
+				// different paths through the forest.
+				bmInverseAckermannQuery = (bmInverseAckermannQuery + 1) % n
+				x := bmInverseAckermannQuery
+				_ = bmInverseAckermannUnionFind.Connected(x, vals[x]%n)
+			},
+			Start:    100000,
+			End:      1000000,
+			Step:     100000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, vals []int) {
+				b.Helper()
+				b.StopTimer()
+				// Join random pairs until about half the elements are
+				// linked, leaving a mix of large and small sets.
+				bmInverseAckermannUnionFind = inverseackermann.NewUnionFind(n)
+				for i := 0; i < n/2; i++ {
+					bmInverseAckermannUnionFind.Union(vals[i]%n, vals[n-1-i]%n)
+				}
+				bmInverseAckermannQuery = 0
+				b.StartTimer()
+			},
+			Cleanup: func(_ *testing.B) {
+				bmInverseAckermannUnionFind = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
 		},
 	}
 
 	// loglogTimeBenchmarks contains O(log(log n)) benchmarks
 	loglogTimeBenchmarks = map[string]BenchmarkSettings{
+		"YFastTrieOperations": {
+			// YFastTrieOperations performs 2.5n operations of O(log log n)
+			// each, so the run as a whole is O(n log log n).
+			ExpectedBigO: bigo.NLogLogN,
+			Sorted:       false,
+			Runner: func(n int, _ []int) {
+				_ = loglog.YFastTrieOperations(n)
+			},
+			Start:      100,
+			End:        10000,
+			Step:       1000,
+			StepMode:   StepLinear,
+			Setup:      nil,
+			Cleanup:    nil,
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"VEBTreeSuccessor": {
+			ExpectedBigO: bigo.LogLog,
+			Sorted:       false,
+			Runner: func(n int, _ []int) {
+				// Odd values are never present, so each query has to find
+				// the neighbouring even value.
+				_, _ = bmLogLogVEBTree.Successor(n | 1)
+				_, _ = bmLogLogVEBTree.Predecessor(n | 1)
+			},
+			Start:    100000,
+			End:      1000000,
+			Step:     100000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, _ []int) {
+				b.Helper()
+				b.StopTimer()
+				// Insert the even values below 2n into the smallest universe
+				// that holds them.
+				bmLogLogVEBTree = loglog.NewVEBTree(1 << bits.Len(uint(2*n)))
+				for i := 0; i < n; i++ {
+					bmLogLogVEBTree.Insert(2 * i)
+				}
+				b.StartTimer()
+			},
+			Cleanup: func(_ *testing.B) {
+				bmLogLogVEBTree = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
 		/*
 			"InterpolationSearch": {
 				ExpectedBigO: bigo.LogLog,
@@ -380,45 +664,123 @@ var (
 						_ = loglog.InterpolationSearch(nonUniformArr, target)
 					}
 				},
-				Start:   100000,
-				End:     1000000,
-				Step:    100000,
-				Setup:   nil,
-				Cleanup: nil,
-			},
-			"YFastTrieOperations": {
-				ExpectedBigO: bigo.LogLog,
-				Sorted:       false,
-				Runner: func(n int, _ []int) {
-					_ = loglog.YFastTrieOperations(n)
-				},
-				Start:   100,
-				End:     10000,
-				Step:    1000,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      100000,
+				End:        1000000,
+				Step:       100000,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 		*/
 	}
 
 	// logarithmicTimeBenchmarks contains O(log n) benchmarks
 	logarithmicTimeBenchmarks = map[string]BenchmarkSettings{
-		/*
-			"BinarySearch": {
-				ExpectedBigO: bigo.Log,
-				Sorted:       true,
-				Runner: func(n int, vals []int) {
-					// Search for value larger than any in array to force
-					// worst-case O(log n).
-					target := vals[len(vals)-1] + 1
-					_ = logarithmic.BinarySearch(vals[:n], target)
-				},
-				Start:   10000,
-				End:     1000000,
-				Step:    100000,
-				Setup:   nil,
-				Cleanup: nil,
+		"BinarySearch": {
+			ExpectedBigO: bigo.Log,
+			Sorted:       true,
+			Runner: func(n int, vals []int) {
+				// Search for a value larger than any in the first n to force
+				// the worst-case O(log n).
+				target := vals[n-1] + 1
+				_ = logarithmic.BinarySearch(vals[:n], target)
+			},
+			Start:      10000,
+			End:        1000000,
+			Step:       100000,
+			StepMode:   StepLinear,
+			Setup:      nil,
+			Cleanup:    nil,
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"ExponentialSearch": {
+			ExpectedBigO: bigo.Log,
+			Sorted:       true,
+			Runner: func(n int, vals []int) {
+				// A target past the end makes the doubling run all the way
+				// out before the binary search, the O(log n) worst case.
+				target := vals[n-1] + 1
+				_ = logarithmic.ExponentialSearch(vals[:n], target)
+			},
+			Start:      10000,
+			End:        1000000,
+			Step:       100000,
+			StepMode:   StepLinear,
+			Setup:      nil,
+			Cleanup:    nil,
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"FenwickTreePrefixSum": {
+			ExpectedBigO: bigo.Log,
+			Sorted:       false,
+			Runner: func(n int, _ []int) {
+				// The last index walks the most tree entries.
+				_ = bmLogarithmicFenwickTree.PrefixSum(n - 1)
+			},
+			Start:    1000,
+			End:      1000000,
+			Step:     100000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, vals []int) {
+				b.Helper()
+				bmLogarithmicFenwickTree = tree.NewFenwickTree(vals[:n])
+			},
+			Cleanup: func(_ *testing.B) {
+				bmLogarithmicFenwickTree = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"SegmentTreeOperations": {
+			ExpectedBigO: bigo.Log,
+			Sorted:       false,
+			Runner: func(n int, _ []int) {
+				// Ranges that straddle the midpoint split at every level,
+				// so both calls visit O(log n) nodes.
+				bmLogarithmicSegmentTree.RangeUpdate(n/4, 3*n/4, 1)
+				_ = bmLogarithmicSegmentTree.Query(n/4+1, 3*n/4-1)
+			},
+			Start:    1000,
+			End:      1000000,
+			Step:     100000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, vals []int) {
+				b.Helper()
+				// Pre-create segment tree to avoid O(n) construction overhead
+				bmLogarithmicSegmentTree = logarithmic.NewSegmentTree(vals[:n])
+			},
+			Cleanup: func(_ *testing.B) {
+				bmLogarithmicSegmentTree = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"FenwickTreeUpdate": {
+			ExpectedBigO: bigo.Log,
+			Sorted:       false,
+			Runner: func(_ int, _ []int) {
+				// Index 0 touches one entry per power of two up to n.
+				bmLogarithmicFenwickTree.Update(0, 1)
 			},
+			Start:    1000,
+			End:      1000000,
+			Step:     100000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, vals []int) {
+				b.Helper()
+				bmLogarithmicFenwickTree = tree.NewFenwickTree(vals[:n])
+			},
+			Cleanup: func(_ *testing.B) {
+				bmLogarithmicFenwickTree = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
+		/*
 			"BinaryTreeSearch": {
 				ExpectedBigO: bigo.Log,
 				Sorted:       false,
@@ -432,9 +794,10 @@ var (
 						_ = logarithmic.BinaryTreeSearch(bmLogarithmicBST, target)
 					}
 				},
-				Start: 250,
-				End:   10000,
-				Step:  250,
+				Start:    250,
+				End:      10000,
+				Step:     250,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -444,132 +807,132 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmLogarithmicBST = nil
 				},
-			},
-			"SegmentTreeOperations": {
-				ExpectedBigO: bigo.Log,
-				Sorted:       false,
-				Runner: func(n int, _ []int) {
-					_ = logarithmic.SegmentTreeOperations(n)
-				},
-				Start: 100,
-				End:   10000,
-				Step:  1000,
-				Setup: func(b *testing.B, n int, vals []int) {
-					b.Helper()
-					// Pre-create segment tree to avoid O(n) construction overhead
-					arr := make([]int, n)
-					for i := 0; i < n; i++ {
-						arr[i] = vals[i%len(vals)]
-					}
-					logarithmic.GlobalSegmentTree = logarithmic.NewSegmentTree(arr)
-				},
-				Cleanup: func(_ *testing.B) {
-					logarithmic.GlobalSegmentTree = nil
-				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 		*/
 	}
 
 	// polylogarithmicTimeBenchmarks contains O((log n)^c) benchmarks
 	polylogarithmicTimeBenchmarks = map[string]BenchmarkSettings{
-		/*
-			"RangeTree2D_Build": {
-				ExpectedBigO: bigo.Polylogarithmic,
-				Sorted:       false,
-				Runner: func(n int, _ []int) {
-					// Generate points for 2D range tree
-					points := make([]polylogarithmic.Point2D, n)
-					for i := 0; i < n; i++ {
-						points[i] = polylogarithmic.Point2D{X: i, Y: i * 2}
-					}
-					_ = polylogarithmic.BuildRangeTree2D(points)
-				},
-				Start:   100,
-				End:     10000,
-				Step:    1000,
-				Setup:   nil,
-				Cleanup: nil,
+		"RangeTree2D_Query": {
+			ExpectedBigO: bigo.Polylogarithmic,
+			Sorted:       false,
+			Runner: func(n int, _ []int) {
+				// Perform multiple queries to amplify the O((log n)^2) behavior
+				numQueries := int(math.Log2(float64(n))) * int(math.Log2(float64(n)))
+				for i := 0; i < numQueries; i++ {
+					querySize := n / (4 + i%3) // Vary query ranges
+					_ = bmPolylogarithmicRangeTree.Query2D(querySize, 3*querySize, querySize*2, 3*querySize*2)
+				}
 			},
-			"RangeTree2D_Query": {
-				ExpectedBigO: bigo.Polylogarithmic,
-				Sorted:       false,
-				Runner: func(n int, _ []int) {
-					// Use global tree that should be pre-built in Setup
-					if globalRangeTree != nil {
-						// Perform multiple queries to amplify the O((log n)^2) behavior
-						numQueries := int(math.Log2(float64(n))) * int(math.Log2(float64(n)))
-						for i := 0; i < numQueries; i++ {
-							querySize := n / (4 + i%3) // Vary query ranges
-							_ = globalRangeTree.Query2D(querySize, 3*querySize, querySize*2, 3*querySize*2)
-						}
-					}
-				},
-				Start: 1000,
-				End:   100000,
-				Step:  10000,
-				Setup: func(b *testing.B, n int, _ []int) {
-					b.Helper()
-					b.StopTimer()
-					// Pre-build tree once for all iterations
-					points := make([]polylogarithmic.Point2D, n)
-					for i := 0; i < n; i++ {
-						points[i] = polylogarithmic.Point2D{X: i, Y: i * 2}
-					}
-					globalRangeTree = polylogarithmic.BuildRangeTree2D(points)
-					b.StartTimer()
-				},
-				Cleanup: func(_ *testing.B) {
-					globalRangeTree = nil
-				},
+			Start:    1000,
+			End:      100000,
+			Step:     10000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, _ []int) {
+				b.Helper()
+				b.StopTimer()
+				// Pre-build tree once for all iterations
+				points := make([]polylogarithmic.Point2D, n)
+				for i := 0; i < n; i++ {
+					points[i] = polylogarithmic.Point2D{X: i, Y: i * 2}
+				}
+				bmPolylogarithmicRangeTree = polylogarithmic.BuildRangeTree2D(points)
+				b.StartTimer()
 			},
-			"FractionalCascadingSearch": {
-				ExpectedBigO: bigo.Polylogarithmic,
-				Sorted:       false,
-				Runner: func(n int, _ []int) {
-					// Create log(n) sorted lists to demonstrate O((log n)^2) complexity
-					logN := int(math.Log2(float64(n))) + 1
-					if logN < 2 {
-						logN = 2
-					}
-					listSize := n / logN
-					if listSize < 1 {
-						listSize = 1
-					}
-					sortedLists := make([][]int, logN)
-					for i := 0; i < logN; i++ {
-						sortedLists[i] = make([]int, listSize)
-						for j := 0; j < listSize; j++ {
-							sortedLists[i][j] = i*listSize + j
-						}
-					}
-
-					// Perform log(n) searches to get O((log n)^2) total complexity
-					for searchCount := 0; searchCount < logN; searchCount++ {
-						target := (n / 2) + searchCount
-						_ = polylogarithmic.FractionalCascadingSearch(sortedLists, target)
+			Cleanup: func(_ *testing.B) {
+				bmPolylogarithmicRangeTree = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"FractionalCascadingSearch": {
+			ExpectedBigO: bigo.Polylogarithmic,
+			Sorted:       false,
+			Runner: func(n int, _ []int) {
+				// Perform log(n) searches across log(n) lists; each search
+				// costs O(log n + log n), for O((log n)^2) in total.
+				logN := bmPolylogarithmicCascadeListCount
+				for searchCount := 0; searchCount < logN; searchCount++ {
+					target := (n / 2) + searchCount
+					_ = bmPolylogarithmicCascade.Search(target)
+				}
+			},
+			Start:    1000,
+			End:      100000,
+			Step:     10000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, _ []int) {
+				b.Helper()
+				b.StopTimer()
+				// Create log(n) sorted lists to demonstrate O((log n)^2) complexity
+				logN := max(int(math.Log2(float64(n)))+1, 2)
+				listSize := max(n/logN, 1)
+				sortedLists := make([][]int, logN)
+				for i := 0; i < logN; i++ {
+					sortedLists[i] = make([]int, listSize)
+					for j := 0; j < listSize; j++ {
+						sortedLists[i][j] = i*listSize + j
 					}
-				},
-				Start:   1000,
-				End:     100000,
-				Step:    10000,
-				Setup:   nil,
-				Cleanup: nil,
+				}
+				bmPolylogarithmicCascadeListCount = logN
+				bmPolylogarithmicCascade = polylogarithmic.BuildFractionalCascade(sortedLists)
+				b.StartTimer()
 			},
-		*/
+			Cleanup: func(_ *testing.B) {
+				bmPolylogarithmicCascadeListCount = 0
+				bmPolylogarithmicCascade = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
 	}
 
 	// linearTimeBenchmarks contains O(n) benchmarks
 	linearTimeBenchmarks = map[string]BenchmarkSettings{
+		"SearchByCase": {
+			// The worst case is O(n), but the best case finds the target
+			// in the first slot and is O(1).
+			ExpectedBigO: bigo.Linear,
+			Sorted:       false,
+			Runner:       func(_ int, vals []int) { _ = linear.Search(vals, searchCaseTarget) },
+			Start:        10000,
+			End:          100000,
+			Step:         10000,
+			StepMode:     StepLinear,
+			Setup:        nil,
+			Cleanup:      nil,
+			Case:         CaseAll,
+			CaseInputs:   searchCaseInputs,
+		},
 		"Search": {
 			ExpectedBigO: bigo.Linear,
 			Sorted:       false,
 			// Search for hopefully non-existent value to force worst-case O(n)
-			Runner:  func(n int, vals []int) { _ = linear.Search(vals[:n], math.MaxInt) },
-			Start:   10000,
-			End:     100000,
-			Step:    10000,
-			Setup:   nil,
-			Cleanup: nil,
+			Runner:     func(n int, vals []int) { _ = linear.Search(vals[:n], math.MaxInt) },
+			Start:      10000,
+			End:        100000,
+			Step:       10000,
+			StepMode:   StepLinear,
+			Setup:      nil,
+			Cleanup:    nil,
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"IndexOf": {
+			ExpectedBigO: bigo.Linear,
+			Sorted:       false,
+			// Search for a non-existent value to force worst-case O(n)
+			Runner:     func(n int, vals []int) { _ = linear.IndexOf(vals[:n], -1) },
+			Start:      10000,
+			End:        100000,
+			Step:       10000,
+			StepMode:   StepLinear,
+			Setup:      nil,
+			Cleanup:    nil,
+			Case:       0,
+			CaseInputs: nil,
 		},
 		"ArrayTraversal": {
 			ExpectedBigO: bigo.Linear,
@@ -580,11 +943,14 @@ var (
 					vals[i] = val * 2
 				}
 			},
-			Start:   10000,
-			End:     100000,
-			Step:    10000,
-			Setup:   nil,
-			Cleanup: nil,
+			Start:      10000,
+			End:        100000,
+			Step:       10000,
+			StepMode:   StepLinear,
+			Setup:      nil,
+			Cleanup:    nil,
+			Case:       0,
+			CaseInputs: nil,
 		},
 		"CountElements": {
 			ExpectedBigO: bigo.Linear,
@@ -593,8 +959,11 @@ var (
 			Start:        10000,
 			End:          100000,
 			Step:         10000,
+			StepMode:     StepLinear,
 			Setup:        nil,
 			Cleanup:      nil,
+			Case:         0,
+			CaseInputs:   nil,
 		},
 		"FindMinimum": {
 			ExpectedBigO: bigo.Linear,
@@ -603,8 +972,11 @@ var (
 			Start:        10000,
 			End:          100000,
 			Step:         10000,
+			StepMode:     StepLinear,
 			Setup:        nil,
 			Cleanup:      nil,
+			Case:         0,
+			CaseInputs:   nil,
 		},
 		"FindMaximum": {
 			ExpectedBigO: bigo.Linear,
@@ -613,8 +985,11 @@ var (
 			Start:        10000,
 			End:          100000,
 			Step:         10000,
+			StepMode:     StepLinear,
 			Setup:        nil,
 			Cleanup:      nil,
+			Case:         0,
+			CaseInputs:   nil,
 		},
 		"CalculateSum": {
 			ExpectedBigO: bigo.Linear,
@@ -623,20 +998,60 @@ var (
 			Start:        10000,
 			End:          100000,
 			Step:         10000,
+			StepMode:     StepLinear,
 			Setup:        nil,
 			Cleanup:      nil,
+			Case:         0,
+			CaseInputs:   nil,
 		},
-		"FindTreeHeight": {
-			// FindTreeHeight visits all nodes, so it's O(n) not O(log n)
+		"MaxSubarraySum": {
+			ExpectedBigO: bigo.Linear,
+			Sorted:       false,
+			Runner:       func(n int, vals []int) { _ = linear.MaxSubarraySum(vals[:n]) },
+			Start:        10000,
+			End:          100000,
+			Step:         10000,
+			StepMode:     StepLinear,
+			Setup:        nil,
+			Cleanup:      nil,
+			Case:         0,
+			CaseInputs:   nil,
+		},
+		"LongestPalindrome": {
+			// Manacher's algorithm on the input that makes expanding around
+			// each center O(n²); compare Quadratic_LongestPalindromeExpand.
 			ExpectedBigO: bigo.Linear,
 			Sorted:       false,
 			Runner: func(_ int, _ []int) {
-				// TODO(rsned): Move from logarithmic to linear package.
-				_ = linear.FindTreeHeight(bmLinearBST)
+				_ = linear.LongestPalindrome(bmLinearPalindrome)
 			},
-			Start: 1000,
-			End:   10000,
-			Step:  1000,
+			Start:    10000,
+			End:      100000,
+			Step:     10000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, _ []int) {
+				b.Helper()
+				b.StopTimer()
+				bmLinearPalindrome = strings.Repeat("a", n)
+				b.StartTimer()
+			},
+			Cleanup: func(_ *testing.B) {
+				bmLinearPalindrome = ""
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"TreeHeight": {
+			// TreeHeight visits all nodes, so it's O(n) not O(log n)
+			ExpectedBigO: bigo.Linear,
+			Sorted:       false,
+			Runner: func(_ int, _ []int) {
+				_ = linear.TreeHeight(bmLinearBST)
+			},
+			Start:    1000,
+			End:      10000,
+			Step:     1000,
+			StepMode: StepLinear,
 			Setup: func(b *testing.B, n int, vals []int) {
 				b.Helper()
 				b.StopTimer()
@@ -646,34 +1061,167 @@ var (
 			Cleanup: func(_ *testing.B) {
 				bmLinearBST = nil
 			},
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"BucketSort": {
+			// Expected O(n) on uniformly distributed values.
+			ExpectedBigO: bigo.Linear,
+			Sorted:       false,
+			Runner: func(_ int, _ []int) {
+				_ = linear.BucketSort(bmLinearBucketSortValues)
+			},
+			Start:    10000,
+			End:      100000,
+			Step:     10000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, vals []int) {
+				b.Helper()
+				b.StopTimer()
+				bmLinearBucketSortValues = make([]float64, n)
+				for i, v := range vals[:n] {
+					bmLinearBucketSortValues[i] = float64(v)
+				}
+				b.StartTimer()
+			},
+			Cleanup: func(_ *testing.B) {
+				bmLinearBucketSortValues = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"CountingSort": {
+			// O(n + k) with the value range k bounded by n.
+			ExpectedBigO: bigo.Linear,
+			Sorted:       false,
+			Runner: func(_ int, _ []int) {
+				_, _ = linear.CountingSort(bmLinearCountingSortValues)
+			},
+			Start:    10000,
+			End:      100000,
+			Step:     10000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, vals []int) {
+				b.Helper()
+				b.StopTimer()
+				bmLinearCountingSortValues = make([]int, n)
+				for i, v := range vals[:n] {
+					bmLinearCountingSortValues[i] = v % n
+				}
+				b.StartTimer()
+			},
+			Cleanup: func(_ *testing.B) {
+				bmLinearCountingSortValues = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"CountingSortBounded": {
+			// O(n + k) with k fixed at 256, so the work grows only with n.
+			ExpectedBigO: bigo.Linear,
+			Sorted:       false,
+			Runner: func(_ int, _ []int) {
+				_, _ = linear.CountingSortBounded(bmLinearCountingSortBounded, 255)
+			},
+			Start:    10000,
+			End:      100000,
+			Step:     10000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, vals []int) {
+				b.Helper()
+				b.StopTimer()
+				bmLinearCountingSortBounded = make([]int, n)
+				for i, v := range vals[:n] {
+					bmLinearCountingSortBounded[i] = v % 256
+				}
+				b.StartTimer()
+			},
+			Cleanup: func(_ *testing.B) {
+				bmLinearCountingSortBounded = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"RadixSort": {
+			// A fixed 8 passes over 64-bit words, so O(n) whatever the values.
+			ExpectedBigO: bigo.Linear,
+			Sorted:       false,
+			Runner: func(_ int, _ []int) {
+				_ = linear.RadixSort(bmLinearRadixSortValues)
+			},
+			Start:    10000,
+			End:      100000,
+			Step:     10000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, vals []int) {
+				b.Helper()
+				b.StopTimer()
+				bmLinearRadixSortValues = make([]int, n)
+				copy(bmLinearRadixSortValues, vals[:n])
+				b.StartTimer()
+			},
+			Cleanup: func(_ *testing.B) {
+				bmLinearRadixSortValues = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"RotateLeft": {
+			ExpectedBigO: bigo.Linear,
+			Sorted:       false,
+			Runner: func(n int, _ []int) {
+				_ = linear.RotateLeft(bmLinearRotateValues, n/2)
+			},
+			Start:    10000,
+			End:      100000,
+			Step:     10000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, vals []int) {
+				b.Helper()
+				b.StopTimer()
+				bmLinearRotateValues = make([]int, n)
+				copy(bmLinearRotateValues, vals[:n])
+				b.StartTimer()
+			},
+			Cleanup: func(_ *testing.B) {
+				bmLinearRotateValues = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
 		},
 		"ParallelDivideConquer": {
 			ExpectedBigO: bigo.Linear,
 			Sorted:       false,
-			Runner:       func(n int, vals []int) { _ = loglog.ParallelDivideConquer(vals[:n]) },
+			Runner:       func(n int, vals []int) { _ = linear.ParallelDivideConquer(vals[:n]) },
 			Start:        10000,
 			End:          100000,
 			Step:         10000,
+			StepMode:     StepLinear,
 			Setup:        nil,
 			Cleanup:      nil,
+			Case:         0,
+			CaseInputs:   nil,
 		},
 	}
 
 	// nLogStarNTimeBenchmarks contains O(n log*(n)) benchmarks
 	nLogStarNTimeBenchmarks = map[string]BenchmarkSettings{
+		"UnionFindOperations": {
+			ExpectedBigO: bigo.NLogStarN,
+			Sorted:       false,
+			Runner: func(n int, _ []int) {
+				_ = nlogstar.PerformUnionFindOperations(n)
+			},
+			Start:      100,
+			End:        1000000,
+			Step:       50000,
+			StepMode:   StepLinear,
+			Setup:      nil,
+			Cleanup:    nil,
+			Case:       0,
+			CaseInputs: nil,
+		},
 		/*
-			"UnionFindOperations": {
-				ExpectedBigO: bigo.NLogStarN,
-				Sorted:       false,
-				Runner: func(n int, _ []int) {
-					_ = nlogstar.PerformUnionFindOperations(n)
-				},
-				Start:   100,
-				End:     1000000,
-				Step:    50000,
-				Setup:   nil,
-				Cleanup: nil,
-			},
 			"KruskalMST": {
 				ExpectedBigO: bigo.NLogStarN,
 				Sorted:       false,
@@ -701,11 +1249,14 @@ var (
 
 					_ = nlogstar.KruskalMST(edges, n)
 				},
-				Start:   100,
-				End:     100000,
-				Step:    10000,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      100,
+				End:        100000,
+				Step:       10000,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"NetworkConnectivity": {
 				ExpectedBigO: bigo.NLogStarN,
@@ -713,17 +1264,42 @@ var (
 				Runner: func(n int, _ []int) {
 					_ = nlogstar.SimulateNetworkConnectivity(n)
 				},
-				Start:   100,
-				End:     1000000,
-				Step:    50000,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      100,
+				End:        1000000,
+				Step:       50000,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 		*/
 	}
 
 	// linearithmicTimeBenchmarks contains O(n log n) benchmarks
 	linearithmicTimeBenchmarks = map[string]BenchmarkSettings{
+		"RangeTree2D_Build": {
+			// Each point is stored on every level of the tree, so building
+			// is O(n log n) even though a query is O((log n)²).
+			ExpectedBigO: bigo.Linearithmic,
+			Sorted:       false,
+			Runner: func(n int, _ []int) {
+				// Generate points for 2D range tree
+				points := make([]polylogarithmic.Point2D, n)
+				for i := 0; i < n; i++ {
+					points[i] = polylogarithmic.Point2D{X: i, Y: i * 2}
+				}
+				_ = polylogarithmic.BuildRangeTree2D(points)
+			},
+			Start:      100,
+			End:        10000,
+			Step:       1000,
+			StepMode:   StepLinear,
+			Setup:      nil,
+			Cleanup:    nil,
+			Case:       0,
+			CaseInputs: nil,
+		},
 		/*
 			"MergeSort": {
 				ExpectedBigO: bigo.Linearithmic,
@@ -735,9 +1311,10 @@ var (
 					// more times on the already sorted data?
 					_ = linearithmic.MergeSort(bmLinearithmicMergeSort)
 				},
-				Start: 100,
-				End:   1000000,
-				Step:  50000,
+				Start:    100,
+				End:      1000000,
+				Step:     50000,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -750,6 +1327,8 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmLinearithmicMergeSort = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"BuildHeapFromArray": {
 				ExpectedBigO: bigo.Linearithmic,
@@ -757,9 +1336,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = linearithmic.BuildHeapFromArray(bmLinearithmicBuildHeapFromArray)
 				},
-				Start: 100,
-				End:   1000000,
-				Step:  50000,
+				Start:    100,
+				End:      1000000,
+				Step:     50000,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -770,6 +1350,8 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmLinearithmicBuildHeapFromArray = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"HeapifyArray": {
 				ExpectedBigO: bigo.Linearithmic,
@@ -777,9 +1359,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = linearithmic.HeapifyArray(bmLinearithmicHeapifyArray)
 				},
-				Start: 100,
-				End:   1000000,
-				Step:  50000,
+				Start:    100,
+				End:      1000000,
+				Step:     50000,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -790,6 +1373,8 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmLinearithmicHeapifyArray = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"IntroSort": {
 				ExpectedBigO: bigo.Linearithmic,
@@ -797,9 +1382,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = linearithmic.IntroSort(bmLinearithmicIntroSort)
 				},
-				Start: 100,
-				End:   1000000,
-				Step:  50000,
+				Start:    100,
+				End:      1000000,
+				Step:     50000,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -810,6 +1396,8 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmLinearithmicIntroSort = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"HeapSort": {
 				ExpectedBigO: bigo.Linearithmic,
@@ -817,9 +1405,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = linearithmic.HeapSort(bmLinearithmicHeapSort)
 				},
-				Start: 100,
-				End:   1000000,
-				Step:  50000,
+				Start:    100,
+				End:      1000000,
+				Step:     50000,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -830,6 +1419,8 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmLinearithmicHeapSort = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"QuickSort": {
 				ExpectedBigO: bigo.Linearithmic,
@@ -837,9 +1428,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = linearithmic.QuickSort(bmLinearithmicQuickSort)
 				},
-				Start: 100,
-				End:   1000000,
-				Step:  50000,
+				Start:    100,
+				End:      1000000,
+				Step:     50000,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -850,16 +1442,21 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmLinearithmicQuickSort = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"RadixSortOptimization": {
-				ExpectedBigO: bigo.Linearithmic, // Moved from LogLog - actually O(n log n)
+				// O(n log n) from the log n comparisons it adds per element
+				// per pass; see Linear_RadixSort for the O(n) version.
+				ExpectedBigO: bigo.Linearithmic,
 				Sorted:       false,
 				Runner: func(_ int, _ []int) {
 					_ = linearithmic.RadixSortOptimization(bmLogLogRadixSort)
 				},
-				Start: 100,
-				End:   1000000,
-				Step:  50000,
+				Start:    100,
+				End:      1000000,
+				Step:     50000,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -870,12 +1467,37 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmLogLogRadixSort = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 		*/
 	}
 
 	// quadraticTimeBenchmarks contains O(n²) benchmarks
 	quadraticTimeBenchmarks = map[string]BenchmarkSettings{
+		"LongestPalindromeExpand": {
+			// Every center of "aaaa...a" expands to an edge of the string.
+			ExpectedBigO: bigo.Quadratic,
+			Sorted:       false,
+			Runner: func(_ int, _ []int) {
+				_ = quadratic.LongestPalindromeExpand(bmQuadraticPalindrome)
+			},
+			Start:    1000,
+			End:      10000,
+			Step:     1000,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, _ []int) {
+				b.Helper()
+				b.StopTimer()
+				bmQuadraticPalindrome = strings.Repeat("a", n)
+				b.StartTimer()
+			},
+			Cleanup: func(_ *testing.B) {
+				bmQuadraticPalindrome = ""
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
 		/*
 			"BubbleSort": {
 				ExpectedBigO: bigo.Quadratic,
@@ -883,9 +1505,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = quadratic.BubbleSort(bmQuadraticBubbleSort)
 				},
-				Start: 100,
-				End:   5000,
-				Step:  200,
+				Start:    100,
+				End:      5000,
+				Step:     200,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -896,6 +1519,8 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmQuadraticBubbleSort = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"InsertionSort": {
 				ExpectedBigO: bigo.Quadratic,
@@ -903,9 +1528,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = quadratic.InsertionSort(bmQuadraticInsertionSort)
 				},
-				Start: 100,
-				End:   5000,
-				Step:  200,
+				Start:    100,
+				End:      5000,
+				Step:     200,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -916,6 +1542,8 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmQuadraticInsertionSort = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"SelectionSort": {
 				ExpectedBigO: bigo.Quadratic,
@@ -923,9 +1551,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = quadratic.SelectionSort(bmQuadraticSelectionSort)
 				},
-				Start: 100,
-				End:   5000,
-				Step:  200,
+				Start:    100,
+				End:      5000,
+				Step:     200,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -936,6 +1565,8 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmQuadraticSelectionSort = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"AllPairsComparison": {
 				ExpectedBigO: bigo.Quadratic,
@@ -943,11 +1574,14 @@ var (
 				Runner: func(n int, vals []int) {
 					_ = quadratic.AllPairsComparison(vals[:n])
 				},
-				Start:   500,
-				End:     5000,
-				Step:    500,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      500,
+				End:        5000,
+				Step:       500,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"FindDuplicatePairs": {
 				ExpectedBigO: bigo.Quadratic,
@@ -955,11 +1589,14 @@ var (
 				Runner: func(n int, vals []int) {
 					_ = quadratic.FindDuplicatePairs(vals[:n])
 				},
-				Start:   500,
-				End:     10000,
-				Step:    500,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      500,
+				End:        10000,
+				Step:       500,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"CountInversions": {
 				ExpectedBigO: bigo.Quadratic,
@@ -967,11 +1604,14 @@ var (
 				Runner: func(n int, vals []int) {
 					_ = quadratic.CountInversions(vals[:n])
 				},
-				Start:   1000,
-				End:     10000,
-				Step:    1000,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      1000,
+				End:        10000,
+				Step:       1000,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"TwoSum": {
 				ExpectedBigO: bigo.Quadratic,
@@ -980,11 +1620,14 @@ var (
 					target := vals[0] + vals[n/2]
 					_ = quadratic.TwoSum(vals[:n], target)
 				},
-				Start:   1000,
-				End:     10000,
-				Step:    1000,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      1000,
+				End:        10000,
+				Step:       1000,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"MatrixTranspose": {
 				ExpectedBigO: bigo.Quadratic,
@@ -992,9 +1635,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = quadratic.MatrixTranspose(bmQuadraticMatrixTranspose)
 				},
-				Start: 500,
-				End:   5000,
-				Step:  500,
+				Start:    500,
+				End:      5000,
+				Step:     500,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -1010,12 +1654,79 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmQuadraticMatrixTranspose = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 		*/
 	}
 
 	// cubicTimeBenchmarks contains O(n³) benchmarks
 	cubicTimeBenchmarks = map[string]BenchmarkSettings{
+		"NaiveMatrixMultiplication": {
+			ExpectedBigO: bigo.Cubic,
+			Sorted:       false,
+			Runner: func(_ int, _ []int) {
+				_, _ = cubic.NaiveMatrixMultiplication(bmCubicNaiveMatrixA, bmCubicNaiveMatrixB)
+			},
+			// Same range as StandardMatrixMultiplication for a direct contrast.
+			Start:    100,
+			End:      1000,
+			Step:     100,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, _ []int) {
+				b.Helper()
+				b.StopTimer()
+				bmCubicNaiveMatrixA = make([][]int, n)
+				bmCubicNaiveMatrixB = make([][]int, n)
+				for i := range bmCubicNaiveMatrixA {
+					bmCubicNaiveMatrixA[i] = make([]int, n)
+					bmCubicNaiveMatrixB[i] = make([]int, n)
+					for j := range bmCubicNaiveMatrixA[i] {
+						bmCubicNaiveMatrixA[i][j] = i*n + j + 1 // vals[rand.Intn((i+1)*n)%n] % 1000
+						bmCubicNaiveMatrixB[i][j] = j*n + i + 1 // vals[rand.Intn((j+1)*n)%n] % 1000
+					}
+				}
+				b.StartTimer()
+			},
+			Cleanup: func(_ *testing.B) {
+				bmCubicNaiveMatrixA = nil
+				bmCubicNaiveMatrixB = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"StandardMatrixMultiplication": {
+			ExpectedBigO: bigo.Cubic,
+			Sorted:       false,
+			Runner: func(_ int, _ []int) {
+				_, _ = cubic.StandardMatrixMultiplication(bmCubicStandardMatrixMultiplicationA, bmCubicStandardMatrixMultiplicationB)
+			},
+			Start:    100,
+			End:      1000,
+			Step:     100,
+			StepMode: StepLinear,
+			Setup: func(b *testing.B, n int, vals []int) {
+				b.Helper()
+				b.StopTimer()
+				bmCubicStandardMatrixMultiplicationA = make([][]int, n)
+				bmCubicStandardMatrixMultiplicationB = make([][]int, n)
+				for i := range bmCubicStandardMatrixMultiplicationA {
+					bmCubicStandardMatrixMultiplicationA[i] = make([]int, n)
+					bmCubicStandardMatrixMultiplicationB[i] = make([]int, n)
+					for j := range bmCubicStandardMatrixMultiplicationA[i] {
+						bmCubicStandardMatrixMultiplicationA[i][j] = vals[(i*n+j)%len(vals)]
+						bmCubicStandardMatrixMultiplicationB[i][j] = vals[(i*n+j+n*n)%len(vals)]
+					}
+				}
+				b.StartTimer()
+			},
+			Cleanup: func(_ *testing.B) {
+				bmCubicStandardMatrixMultiplicationA = nil
+				bmCubicStandardMatrixMultiplicationB = nil
+			},
+			Case:       0,
+			CaseInputs: nil,
+		},
 		/*
 			"FloydWarshall": {
 				ExpectedBigO: bigo.Cubic,
@@ -1023,9 +1734,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = cubic.FloydWarshall(bmCubicFloydWarshallGraph)
 				},
-				Start: 10,
-				End:   100,
-				Step:  10,
+				Start:    10,
+				End:      100,
+				Step:     10,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, _ []int) {
 					b.Helper()
 					b.StopTimer()
@@ -1045,6 +1757,8 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmCubicFloydWarshallGraph = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"ThreeSum": {
 				ExpectedBigO: bigo.Cubic,
@@ -1053,40 +1767,14 @@ var (
 					target := vals[0] + vals[1] + vals[2] // Use first three values as target
 					_ = cubic.ThreeSum(vals, target)
 				},
-				Start:   100,
-				End:     1000,
-				Step:    100,
-				Setup:   nil,
-				Cleanup: nil,
-			},
-			"NaiveMatrixMultiplication": {
-				ExpectedBigO: bigo.Cubic,
-				Sorted:       false,
-				Runner: func(_ int, _ []int) {
-					_ = quadratic.NaiveMatrixMultiplication(bmQuadraticNaiveMatrixA, bmQuadraticNaiveMatrixB)
-				},
-				Start: 3,
-				End:   20,
-				Step:  1,
-				Setup: func(b *testing.B, n int, _ []int) {
-					b.Helper()
-					b.StopTimer()
-					bmQuadraticNaiveMatrixA = make([][]int, n)
-					bmQuadraticNaiveMatrixB = make([][]int, n)
-					for i := range bmQuadraticNaiveMatrixA {
-						bmQuadraticNaiveMatrixA[i] = make([]int, n)
-						bmQuadraticNaiveMatrixB[i] = make([]int, n)
-						for j := range bmQuadraticNaiveMatrixA[i] {
-							bmQuadraticNaiveMatrixA[i][j] = i*n + j + 1 // vals[rand.Intn((i+1)*n)%n] % 1000
-							bmQuadraticNaiveMatrixB[i][j] = j*n + i + 1 // vals[rand.Intn((j+1)*n)%n] % 1000
-						}
-					}
-					b.StartTimer()
-				},
-				Cleanup: func(_ *testing.B) {
-					bmQuadraticNaiveMatrixA = nil
-					bmQuadraticNaiveMatrixB = nil
-				},
+				Start:      100,
+				End:        1000,
+				Step:       100,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"MatrixChainMultiplication": {
 				ExpectedBigO: bigo.Cubic,
@@ -1094,9 +1782,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = cubic.MatrixChainMultiplication(bmCubicMatrixChainMultiplication)
 				},
-				Start: 50,
-				End:   750,
-				Step:  50,
+				Start:    50,
+				End:      750,
+				Step:     50,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -1109,6 +1798,8 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmCubicMatrixChainMultiplication = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"OptimalBinarySearchTree": {
 				ExpectedBigO: bigo.Cubic,
@@ -1116,9 +1807,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = cubic.OptimalBinarySearchTree(bmCubicOptimalBSTKeys, bmCubicOptimalBSTFreq)
 				},
-				Start: 50,
-				End:   500,
-				Step:  50,
+				Start:    50,
+				End:      500,
+				Step:     50,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -1134,35 +1826,8 @@ var (
 					bmCubicOptimalBSTKeys = nil
 					bmCubicOptimalBSTFreq = nil
 				},
-			},
-			"StandardMatrixMultiplication": {
-				ExpectedBigO: bigo.Cubic,
-				Sorted:       false,
-				Runner: func(_ int, _ []int) {
-					_ = cubic.StandardMatrixMultiplication(bmCubicStandardMatrixMultiplicationA, bmCubicStandardMatrixMultiplicationB)
-				},
-				Start: 100,
-				End:   1000,
-				Step:  100,
-				Setup: func(b *testing.B, n int, vals []int) {
-					b.Helper()
-					b.StopTimer()
-					bmCubicStandardMatrixMultiplicationA = make([][]int, n)
-					bmCubicStandardMatrixMultiplicationB = make([][]int, n)
-					for i := range bmCubicStandardMatrixMultiplicationA {
-						bmCubicStandardMatrixMultiplicationA[i] = make([]int, n)
-						bmCubicStandardMatrixMultiplicationB[i] = make([]int, n)
-						for j := range bmCubicStandardMatrixMultiplicationA[i] {
-							bmCubicStandardMatrixMultiplicationA[i][j] = vals[(i*n+j)%len(vals)]
-							bmCubicStandardMatrixMultiplicationB[i][j] = vals[(i*n+j+n*n)%len(vals)]
-						}
-					}
-					b.StartTimer()
-				},
-				Cleanup: func(_ *testing.B) {
-					bmCubicStandardMatrixMultiplicationA = nil
-					bmCubicStandardMatrixMultiplicationB = nil
-				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"TripleNestedProductSum": {
 				ExpectedBigO: bigo.Cubic,
@@ -1170,11 +1835,14 @@ var (
 				Runner: func(n int, vals []int) {
 					_ = cubic.TripleNestedProductSum(vals[:n])
 				},
-				Start:   250,
-				End:     2500,
-				Step:    250,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      250,
+				End:        2500,
+				Step:       250,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"FindTripletsWithSum": {
 				ExpectedBigO: bigo.Cubic,
@@ -1186,11 +1854,14 @@ var (
 					targetSum := vals[0] + vals[1] + vals[2] // Use first three as target
 					_ = cubic.FindTripletsWithSum(vals[:n], targetSum)
 				},
-				Start:   250,
-				End:     2500,
-				Step:    250,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      250,
+				End:        2500,
+				Step:       250,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"CountTripletsWithProperty": {
 				ExpectedBigO: bigo.Cubic,
@@ -1198,11 +1869,14 @@ var (
 				Runner: func(n int, vals []int) {
 					_ = cubic.CountTripletsWithProperty(vals[:n])
 				},
-				Start:   250,
-				End:     2500,
-				Step:    250,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      250,
+				End:        2500,
+				Step:       250,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"Generate3DCombinations": {
 				ExpectedBigO: bigo.Cubic,
@@ -1210,11 +1884,14 @@ var (
 				Runner: func(n int, vals []int) {
 					_ = cubic.Generate3DCombinations(vals[:n])
 				},
-				Start:   5,
-				End:     50,
-				Step:    5,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      5,
+				End:        50,
+				Step:       5,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 		*/
 	}
@@ -1228,11 +1905,14 @@ var (
 				Runner: func(n int, _ []int) {
 					_ = exponential.RecursiveFibonacci(n)
 				},
-				Start:   20,
-				End:     30,
-				Step:    1,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      20,
+				End:        30,
+				Step:       1,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"TowerOfHanoi": {
 				ExpectedBigO: bigo.Exponential,
@@ -1241,11 +1921,14 @@ var (
 					// Use TowerOfHanoi instead of TowerOfHanoiCount to actually perform exponential work
 					_ = exponential.TowerOfHanoi(n, "A", "B", "C")
 				},
-				Start:   5,
-				End:     20,
-				Step:    1,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      5,
+				End:        20,
+				Step:       1,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"GenerateAllSubsets": {
 				ExpectedBigO: bigo.Exponential,
@@ -1254,11 +1937,14 @@ var (
 					// Add cap to prevent excessive runtime (2^22 = 4M operations)?
 					_ = exponential.GenerateAllSubsets(vals[:n])
 				},
-				Start:   5,
-				End:     21,
-				Step:    1,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      5,
+				End:        21,
+				Step:       1,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"TravelingSalesmanBruteForce": {
 				ExpectedBigO: bigo.Exponential,
@@ -1279,11 +1965,14 @@ var (
 					}
 					_, _ = exponential.TravelingSalesmanBruteForce(distances)
 				},
-				Start:   3,
-				End:     10,
-				Step:    1,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      3,
+				End:        10,
+				Step:       1,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"TSPBitMask": {
 				ExpectedBigO: bigo.Exponential,
@@ -1291,9 +1980,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = exponential.TSPBitMask(bmExponentialTSPBitMaskDistances)
 				},
-				Start: 3,
-				End:   10,
-				Step:  1,
+				Start:    3,
+				End:      10,
+				Step:     1,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -1313,6 +2003,8 @@ var (
 				Cleanup: func(_ *testing.B) {
 					bmExponentialTSPBitMaskDistances = nil
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 		*/
 	}
@@ -1326,9 +2018,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = polynomial.EditDistance(bmPolynomialEditDistanceS1, bmPolynomialEditDistanceS2)
 				},
-				Start: 20,
-				End:   200,
-				Step:  20,
+				Start:    20,
+				End:      200,
+				Step:     20,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -1342,7 +2035,9 @@ var (
 					}
 					b.StartTimer()
 				},
-				Cleanup: nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"JohnsonAlgorithm": {
 				ExpectedBigO: bigo.Polynomial,
@@ -1361,11 +2056,14 @@ var (
 					}
 					_ = polynomial.JohnsonAlgorithm(graph)
 				},
-				Start:   5,
-				End:     50,
-				Step:    5,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      5,
+				End:        50,
+				Step:       5,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"LongestCommonSubsequence": {
 				ExpectedBigO: bigo.Polynomial,
@@ -1373,9 +2071,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = polynomial.LongestCommonSubsequence(bmPolynomialLongestCommonSubsequenceS1, bmPolynomialLongestCommonSubsequenceS2)
 				},
-				Start: 20,
-				End:   200,
-				Step:  20,
+				Start:    20,
+				End:      200,
+				Step:     20,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -1389,7 +2088,9 @@ var (
 					}
 					b.StartTimer()
 				},
-				Cleanup: nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"LCSWithSequence": {
 				ExpectedBigO: bigo.Polynomial,
@@ -1397,9 +2098,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_ = polynomial.LCSWithSequence(bmPolynomialLCSWithSequenceS1, bmPolynomialLCSWithSequenceS2)
 				},
-				Start: 20,
-				End:   200,
-				Step:  20,
+				Start:    20,
+				End:      200,
+				Step:     20,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -1415,6 +2117,8 @@ var (
 					bmPolynomialLCSWithSequenceS1 = ""
 					bmPolynomialLCSWithSequenceS2 = ""
 				},
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"MatrixChainOrder": {
 				ExpectedBigO: bigo.Polynomial,
@@ -1422,9 +2126,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_, _ = polynomial.MatrixChainOrder(bmPolynomialMatrixChainOrderDimensions)
 				},
-				Start: 5,
-				End:   50,
-				Step:  5,
+				Start:    5,
+				End:      50,
+				Step:     5,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					b.StopTimer()
@@ -1434,7 +2139,9 @@ var (
 					}
 					b.StartTimer()
 				},
-				Cleanup: nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"FordFulkerson": {
 				ExpectedBigO: bigo.Polynomial,
@@ -1461,11 +2168,14 @@ var (
 						}
 					}
 				},
-				Start:   5,
-				End:     50,
-				Step:    5,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      5,
+				End:        50,
+				Step:       5,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"EdmondsKarp": {
 				ExpectedBigO: bigo.Polynomial,
@@ -1490,17 +2200,63 @@ var (
 						}
 					}
 				},
-				Start:   5,
-				End:     50,
-				Step:    5,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      5,
+				End:        50,
+				Step:       5,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 		*/
 	}
 
 	// factorialTimeBenchmarks contains O(n!) benchmarks
 	factorialTimeBenchmarks = map[string]BenchmarkSettings{
+		"OptimalMatchingBruteForce": {
+			ExpectedBigO: bigo.Factorial,
+			Sorted:       false,
+			Runner: func(n int, vals []int) {
+				size := min(n, 8) // Matrix sizes directly match n
+				weights := make([][]int, size)
+				for i := range weights {
+					weights[i] = make([]int, size)
+					for j := range weights[i] {
+						weights[i][j] = vals[(i*size+j)%len(vals)]%100 + 1
+					}
+				}
+				_, _ = factorial.OptimalMatchingBruteForce(weights)
+			},
+			Start:      1,
+			End:        8,
+			Step:       1,
+			StepMode:   StepLinear,
+			Setup:      nil,
+			Cleanup:    nil,
+			Case:       0,
+			CaseInputs: nil,
+		},
+		"JobShopSchedulingBruteForce": {
+			ExpectedBigO: bigo.Factorial,
+			Sorted:       false,
+			Runner: func(n int, vals []int) {
+				jobCount := min(n, 8) // Job counts directly match n
+				jobs := make([][]int, jobCount)
+				for i := range jobs {
+					jobs[i] = []int{vals[i%len(vals)]%10 + 1, vals[(i+1)%len(vals)]%10 + 1}
+				}
+				_, _ = factorial.JobShopSchedulingBruteForce(jobs)
+			},
+			Start:      1,
+			End:        8,
+			Step:       1,
+			StepMode:   StepLinear,
+			Setup:      nil,
+			Cleanup:    nil,
+			Case:       0,
+			CaseInputs: nil,
+		},
 		/*
 			"GenerateAllPermutations": {
 				ExpectedBigO: bigo.Factorial,
@@ -1508,11 +2264,14 @@ var (
 				Runner: func(n int, vals []int) {
 					_ = factorial.GenerateAllPermutations(vals[:n])
 				},
-				Start:   1,
-				End:     10,
-				Step:    1,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      1,
+				End:        10,
+				Step:       1,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"AssignmentProblemBruteForce": {
 				ExpectedBigO: bigo.Factorial,
@@ -1520,9 +2279,10 @@ var (
 				Runner: func(_ int, _ []int) {
 					_, _ = factorial.AssignmentProblemBruteForce(bmFactorialAssignmentMatrix)
 				},
-				Start: 2,
-				End:   10,
-				Step:  1,
+				Start:    2,
+				End:      10,
+				Step:     1,
+				StepMode: StepLinear,
 				Setup: func(b *testing.B, n int, vals []int) {
 					b.Helper()
 					size := min(1+n, 25) // Sizes 2 - n
@@ -1540,29 +2300,8 @@ var (
 					bmFactorialAssignmentMatrix = nil
 					b.StopTimer()
 				},
-			},
-			"OptimalMatchingBruteForce": {
-				ExpectedBigO: bigo.Factorial,
-				Sorted:       false,
-				Runner: func(n int, vals []int) {
-					size := 2 + n // Sizes 2-5
-					if size > 25 {
-						size = 25
-					}
-					weights := make([][]int, size)
-					for i := range weights {
-						weights[i] = make([]int, size)
-						for j := range weights[i] {
-							weights[i][j] = vals[(i*size+j)%len(vals)]%100 + 1
-						}
-					}
-					_, _ = factorial.OptimalMatchingBruteForce(weights)
-				},
-				Start:   1,
-				End:     8,
-				Step:    1,
-				Setup:   nil,
-				Cleanup: nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"NQueensAllArrangements": {
 				ExpectedBigO: bigo.Factorial,
@@ -1571,11 +2310,14 @@ var (
 					queenN := min(n, 8) // N-Queens sizes directly match n
 					_ = factorial.NQueensAllArrangements(queenN)
 				},
-				Start:   1,
-				End:     6, // Going beyond this puts really puts the hurt on your machine.
-				Step:    1,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      1,
+				End:        6, // Going beyond this puts really puts the hurt on your machine.
+				Step:       1,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"NQueensCountAllArrangements": {
 				ExpectedBigO: bigo.Factorial,
@@ -1584,11 +2326,14 @@ var (
 					queenN := min(n, 8) // N-Queens sizes directly match n
 					_ = factorial.NQueensCountAllArrangements(queenN)
 				},
-				Start:   1,
-				End:     6, // Going beyond this puts really puts the hurt on your machine.
-				Step:    1,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      1,
+				End:        6, // Going beyond this puts really puts the hurt on your machine.
+				Step:       1,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"GenerateAllSchedules": {
 				ExpectedBigO: bigo.Factorial,
@@ -1604,11 +2349,14 @@ var (
 					}
 					_ = factorial.GenerateAllSchedules(tasks)
 				},
-				Start:   1,
-				End:     8,
-				Step:    1,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      1,
+				End:        8,
+				Step:       1,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"OptimalScheduleBruteForce": {
 				ExpectedBigO: bigo.Factorial,
@@ -1624,31 +2372,14 @@ var (
 					}
 					_, _ = factorial.OptimalScheduleBruteForce(tasks)
 				},
-				Start:   1,
-				End:     8,
-				Step:    1,
-				Setup:   nil,
-				Cleanup: nil,
-			},
-			"JobShopSchedulingBruteForce": {
-				ExpectedBigO: bigo.Factorial,
-				Sorted:       false,
-				Runner: func(n int, vals []int) {
-					jobCount := n // Job counts directly match n
-					if jobCount > 8 {
-						jobCount = 8
-					}
-					jobs := make([][]int, jobCount)
-					for i := range jobs {
-						jobs[i] = []int{vals[i%len(vals)]%10 + 1, vals[(i+1)%len(vals)]%10 + 1}
-					}
-					_, _ = factorial.JobShopSchedulingBruteForce(jobs)
-				},
-				Start:   1,
-				End:     8,
-				Step:    1,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      1,
+				End:        8,
+				Step:       1,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 			"TSPBruteForceAllRoutes": {
 				ExpectedBigO: bigo.Factorial,
@@ -1674,11 +2405,14 @@ var (
 					}
 					_, _ = factorial.TSPBruteForceAllRoutes(distances)
 				},
-				Start:   1,
-				End:     10,
-				Step:    1,
-				Setup:   nil,
-				Cleanup: nil,
+				Start:      1,
+				End:        10,
+				Step:       1,
+				StepMode:   StepLinear,
+				Setup:      nil,
+				Cleanup:    nil,
+				Case:       0,
+				CaseInputs: nil,
 			},
 		*/
 	}
@@ -1693,8 +2427,11 @@ var (
 				Start:        1,
 				End:          8,
 				Step:         1,
+				StepMode:     StepLinear,
 				Setup:        nil,
 				Cleanup:      nil,
+				Case:         0,
+				CaseInputs:   nil,
 			},
 			"CompleteGraphColoring": {
 				ExpectedBigO: bigo.HyperExponential,
@@ -1703,8 +2440,11 @@ var (
 				Start:        1,
 				End:          8,
 				Step:         1,
+				StepMode:     StepLinear,
 				Setup:        nil,
 				Cleanup:      nil,
+				Case:         0,
+				CaseInputs:   nil,
 			},
 			"GenerateAllPasswords": {
 				ExpectedBigO: bigo.HyperExponential,
@@ -1713,8 +2453,11 @@ var (
 				Start:        1,
 				End:          8,
 				Step:         1,
+				StepMode:     StepLinear,
 				Setup:        nil,
 				Cleanup:      nil,
+				Case:         0,
+				CaseInputs:   nil,
 			},
 			"WorkSimulation": {
 				ExpectedBigO: bigo.HyperExponential,
@@ -1723,8 +2466,11 @@ var (
 				Start:        1,
 				End:          8,
 				Step:         1,
+				StepMode:     StepLinear,
 				Setup:        nil,
 				Cleanup:      nil,
+				Case:         0,
+				CaseInputs:   nil,
 			},
 		*/
 	}
@@ -1744,6 +2490,9 @@ func init() {
 	for k, v := range constantTimeBenchmarks {
 		exampleMethodsBenchmarkSettings[fmt.Sprintf("Constant_%s", k)] = v
 	}
+	for k, v := range inverseAckermannTimeBenchmarks {
+		exampleMethodsBenchmarkSettings[fmt.Sprintf("InverseAckermann_%s", k)] = v
+	}
 	for k, v := range loglogTimeBenchmarks {
 		exampleMethodsBenchmarkSettings[fmt.Sprintf("LogLog_%s", k)] = v
 	}
@@ -1798,10 +2547,36 @@ func BenchmarkExampleMethod(b *testing.B) {
 // BenchmarkAllBigOExamples is a benchmark harness to run all of the example
 // methods. Consider increasing the timeout to 15m or more to allow it to
 // really every benchmark here with the each ones full range of values.
+//
+// If -benchmark_category is set, only the example methods in that category
+// are run.
 func BenchmarkAllBigOExamples(b *testing.B) {
+	prefix := categoryPrefix(*benchmarkCategory)
+
+	ran := false
 	for name, settings := range exampleMethodsBenchmarkSettings {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		ran = true
 		runOneBenchmark(b, name, settings)
 	}
+
+	if !ran {
+		b.Logf("Warning: No benchmarks found for category %q", *benchmarkCategory)
+	}
+}
+
+// categoryPrefix returns the name prefix for the given category. The trailing
+// underscore is optional, so "Linear" matches Linear_Search but not
+// Linearithmic_MergeSort.
+func categoryPrefix(category string) string {
+	if category == "" || strings.HasSuffix(category, "_") {
+		return category
+	}
+
+	return category + "_"
 }
 
 // runOneBenchmark is a helper function to run a single benchmark with the given settings.
@@ -1826,6 +2601,13 @@ func runOneBenchmark(b *testing.B, name string, settings BenchmarkSettings) {
 	if *benchmarkStep != benchmarkStepDefault {
 		step = *benchmarkStep
 	}
+	if *benchmarkStepMode != "" {
+		mode, err := parseStepMode(*benchmarkStepMode)
+		if err != nil {
+			b.Skipf("Invalid benchmark step mode: %v", err)
+		}
+		settings.StepMode = mode
+	}
 
 	// Validate the range
 	if start >= end {
@@ -1834,8 +2616,45 @@ func runOneBenchmark(b *testing.B, name string, settings BenchmarkSettings) {
 	if step <= 0 {
 		b.Skipf("Invalid benchmark step: %d", step)
 	}
+	if settings.StepMode == StepGeometric && (start <= 0 || step < 2) {
+		b.Skipf("Invalid geometric benchmark range: start (%d) must be positive and step (%d) at least 2", start, step)
+	}
+
+	if settings.Case == 0 {
+		runBenchmarkRange(b, name, settings, start, end, step, 0)
+
+		return
+	}
+
+	for _, c := range benchmarkCases {
+		if settings.Case&c != 0 {
+			runBenchmarkRange(b, fmt.Sprintf("%s_%s", name, c), settings, start, end, step, c)
+		}
+	}
+}
+
+// runBenchmarkRange runs the benchmark for each n in the range as a subtest
+// labeled with the name. If a case is given and the settings have a
+// CaseInputs hook, the Runner is given the values for that case.
+func runBenchmarkRange(b *testing.B, name string, settings BenchmarkSettings, start, end, step int, c BenchmarkCase) {
+	b.Helper()
+
+	var csvOut *csv.Writer
+	if *benchmarkCSVOut != "" {
+		path := csvOutPath(*benchmarkCSVOut, name)
+		f, err := openCSVOut(path)
+		if err != nil {
+			b.Fatalf("Unable to open %s: %v", path, err)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				b.Errorf("Unable to close %s: %v", path, err)
+			}
+		}()
+		csvOut = csv.NewWriter(f)
+	}
 
-	for n := start; n <= end; n += step {
+	for n := start; n <= end; n = nextN(n, step, settings.StepMode) {
 		var vals []int
 		if settings.Sorted {
 			vals = testIntValsSorted[:n]
@@ -1843,17 +2662,29 @@ func runOneBenchmark(b *testing.B, name string, settings BenchmarkSettings) {
 			vals = testIntVals[:n]
 		}
 
+		// The benchmark function is called with increasing b.N until the
+		// timing is stable, so this ends up holding the final ns/op.
+		var nsPerOp float64
 		b.Run(fmt.Sprintf("%s_n=%d", name, n),
 			func(b *testing.B) {
+				b.StopTimer()
+				if c != 0 && settings.CaseInputs != nil {
+					vals = settings.CaseInputs(c, n, vals)
+				}
 				if settings.Setup != nil {
-					b.StopTimer()
 					settings.Setup(b, n, vals)
-					b.StartTimer()
 				}
+				b.StartTimer()
 				for b.Loop() {
 					settings.Runner(n, vals)
 				}
+				nsPerOp = float64(b.Elapsed().Nanoseconds()) / float64(b.N)
 			})
+		if csvOut != nil && nsPerOp > 0 {
+			if err := writeBenchmarkRow(csvOut, n, nsPerOp); err != nil {
+				b.Fatalf("Unable to write to %s: %v", csvOutPath(*benchmarkCSVOut, name), err)
+			}
+		}
 		if settings.Cleanup != nil {
 			b.Cleanup(func() {
 				settings.Cleanup(b)
@@ -1861,3 +2692,117 @@ func runOneBenchmark(b *testing.B, name string, settings BenchmarkSettings) {
 		}
 	}
 }
+
+// csvOutPath returns the file the benchmark called name writes its rows to:
+// the name is inserted before the extension of base, so out.csv becomes
+// out_Linear_Search.csv. Keeping every benchmark and case in its own file
+// means each file describes a single function and input regime, which is
+// what Classifier.LoadCSV expects.
+func csvOutPath(base, name string) string {
+	ext := filepath.Ext(base)
+
+	return fmt.Sprintf("%s_%s%s", strings.TrimSuffix(base, ext), name, ext)
+}
+
+// openCSVOut opens path for writing benchmark rows. The first open in this
+// run truncates the file and later opens append to it.
+func openCSVOut(path string) (*os.File, error) {
+	flags := os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	if !csvOutStarted[path] {
+		flags |= os.O_TRUNC
+	}
+
+	f, err := os.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	csvOutStarted[path] = true
+
+	return f, nil
+}
+
+// writeBenchmarkRow writes a single n,ns/op row with no header, the default
+// layout Classifier.LoadCSV reads, and flushes it.
+func writeBenchmarkRow(w *csv.Writer, n int, nsPerOp float64) error {
+	if err := w.Write([]string{strconv.Itoa(n), strconv.FormatFloat(nsPerOp, 'g', -1, 64)}); err != nil {
+		return err
+	}
+	w.Flush()
+
+	return w.Error()
+}
+
+func TestCSVOutPath(t *testing.T) {
+	tests := []struct {
+		base, name, want string
+	}{
+		{"search.csv", "Linear_Search", "search_Linear_Search.csv"},
+		{"out/bench.csv", "Linear_Search_Worst", "out/bench_Linear_Search_Worst.csv"},
+		{"bench", "Linear_Search", "bench_Linear_Search"},
+		{"out.d/bench.data.csv", "Constant_Add", "out.d/bench.data_Constant_Add.csv"},
+	}
+
+	for _, tt := range tests {
+		if got := csvOutPath(tt.base, tt.name); got != tt.want {
+			t.Errorf("csvOutPath(%q, %q) = %q, want %q", tt.base, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestOpenCSVOutAppendsWithinARun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bench_Linear_Search.csv")
+	if err := os.WriteFile(path, []byte("1,1\n"), 0o644); err != nil {
+		t.Fatalf("os.WriteFile(%q) returned error: %v", path, err)
+	}
+
+	// The first open replaces the stale file, the second appends.
+	for _, row := range []bigo.DataPoint{{N: 100, Value: 10}, {N: 100, Value: 11}} {
+		f, err := openCSVOut(path)
+		if err != nil {
+			t.Fatalf("openCSVOut(%q) returned error: %v", path, err)
+		}
+
+		if err := writeBenchmarkRow(csv.NewWriter(f), row.N, row.Value); err != nil {
+			t.Fatalf("writeBenchmarkRow(%d, %v) returned error: %v", row.N, row.Value, err)
+		}
+
+		if err := f.Close(); err != nil {
+			t.Fatalf("Close() returned error: %v", err)
+		}
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile(%q) returned error: %v", path, err)
+	}
+
+	if want := "100,10\n100,11\n"; string(got) != want {
+		t.Errorf("file contents = %q, want %q", got, want)
+	}
+}
+
+func TestWriteBenchmarkRowLoadCSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bench.csv")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("os.Create(%q) returned error: %v", path, err)
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	rows := []bigo.DataPoint{{N: 100, Value: 12.5}, {N: 200, Value: 25.125}, {N: 200, Value: 26}, {N: 300, Value: 1e-7}}
+	for _, r := range rows {
+		if err := writeBenchmarkRow(w, r.N, r.Value); err != nil {
+			t.Fatalf("writeBenchmarkRow(%d, %v) returned error: %v", r.N, r.Value, err)
+		}
+	}
+
+	c := bigo.NewClassifier()
+	if err := c.LoadCSV(path, false, ','); err != nil {
+		t.Fatalf("LoadCSV(%q) returned error: %v", path, err)
+	}
+
+	if got := c.Records(); !slices.Equal(got, rows) {
+		t.Errorf("LoadCSV() of written rows = %v, want %v", got, rows)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constant
+
+// AmortizedAppendDemo appends n elements to a dynamic array that doubles its
+// capacity whenever it fills up, and returns the number of appends performed
+// along with the number of element copies the resizes caused.
+//
+// A single append is O(n) in the worst case because a resize has to copy
+// every existing element into the new backing array. Since the capacity
+// doubles, the resizes copy 1 + 2 + 4 + ... + n/2 elements in total, which is
+// always less than 2n. Spread across n appends this is fewer than two copies
+// per append, which is what makes append amortized O(1).
+//
+// For n a power of two the total number of copies is exactly n-1.
+func AmortizedAppendDemo(n int) (int, int) {
+	totalAppends, totalCopies := 0, 0
+
+	capacity := 1
+	arr := make([]int, 0, capacity)
+
+	for i := range n {
+		if len(arr) == capacity {
+			// Full: allocate double the capacity and copy everything
+			// over by hand so each element move is counted.
+			capacity *= 2
+			grown := make([]int, len(arr), capacity)
+			for j := range arr {
+				grown[j] = arr[j]
+				totalCopies++
+			}
+
+			arr = grown
+		}
+
+		// Appending into spare capacity never reallocates, so this
+		// write is the O(1) part of the operation.
+		arr = append(arr, i)
+		totalAppends++
+	}
+
+	return totalAppends, totalCopies
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package constant
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAmortizedAppendDemo(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 5, 7, 100, 1000, 1023, 1025, 100000} {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			appends, copies := AmortizedAppendDemo(n)
+			if appends != n {
+				t.Errorf("AmortizedAppendDemo(%d) appends = %d, want %d", n, appends, n)
+			}
+
+			if n > 0 && copies >= 2*n {
+				t.Errorf("AmortizedAppendDemo(%d) copies = %d, want < %d", n, copies, 2*n)
+			}
+		})
+	}
+}
+
+func TestAmortizedAppendDemoPowersOfTwo(t *testing.T) {
+	for k := range 18 {
+		n := 1 << k
+		_, copies := AmortizedAppendDemo(n)
+		if copies != n-1 {
+			t.Errorf("AmortizedAppendDemo(%d) copies = %d, want %d", n, copies, n-1)
+		}
+	}
+}
+
+func BenchmarkAmortizedAppendDemo(b *testing.B) {
+	sizes := []int{10, 100, 1000, 10000, 100000}
+
+	for _, n := range sizes {
+		b.Run(fmt.Sprintf("AmortizedAppendDemo-%d", n), func(b *testing.B) {
+			for b.Loop() {
+				AmortizedAppendDemo(n)
+			}
+		})
+	}
+}
@@ -17,6 +17,9 @@ package constant
 // Queue represents a simple queue data structure.
 // This implementation uses a slice with a front index to avoid
 // expensive slice operations during dequeue.
+//
+// It only holds ints for the constant time benchmarks. For a general purpose
+// queue use collection.Queue.
 type Queue struct {
 	items []int // Slice to store queue elements
 	front int   // Index of the front element (for O(1) dequeue)
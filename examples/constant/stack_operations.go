@@ -17,6 +17,9 @@ package constant
 // DynamicStack represents a simple stack data structure.
 // This implementation uses a slice that grows dynamically as needed,
 // providing O(1) amortized time for push/pop operations.
+//
+// It only holds ints for the constant time benchmarks. For a general purpose
+// stack use collection.Stack.
 type DynamicStack struct {
 	items []int // Slice to store stack elements (top is at the end)
 }
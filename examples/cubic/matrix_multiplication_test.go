@@ -0,0 +1,208 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cubic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// multiplyFuncs are the matrix multiplications in this package. They differ
+// only in loop order, so every one must give the same results.
+var multiplyFuncs = []struct {
+	name string
+	fn   func(a, b [][]int) ([][]int, error)
+}{
+	{"StandardMatrixMultiplication", StandardMatrixMultiplication},
+	{"NaiveMatrixMultiplication", NaiveMatrixMultiplication},
+}
+
+func TestMatrixMultiplication(t *testing.T) {
+	tests := []struct {
+		name string
+		a    [][]int
+		b    [][]int
+		want [][]int
+	}{
+		{
+			name: "2x2",
+			a:    [][]int{{1, 2}, {3, 4}},
+			b:    [][]int{{5, 6}, {7, 8}},
+			want: [][]int{{19, 22}, {43, 50}},
+		},
+		{
+			name: "2x3 times 3x2",
+			a:    [][]int{{1, 2, 3}, {4, 5, 6}},
+			b:    [][]int{{7, 8}, {9, 10}, {11, 12}},
+			want: [][]int{{58, 64}, {139, 154}},
+		},
+		{
+			name: "row times column",
+			a:    [][]int{{1, 2, 3}},
+			b:    [][]int{{4}, {5}, {6}},
+			want: [][]int{{32}},
+		},
+		{
+			name: "column times row",
+			a:    [][]int{{1}, {2}},
+			b:    [][]int{{3, 4}},
+			want: [][]int{{3, 4}, {6, 8}},
+		},
+		{
+			name: "negative values",
+			a:    [][]int{{-1, 2}, {0, -3}},
+			b:    [][]int{{4, -5}, {6, 7}},
+			want: [][]int{{8, 19}, {-18, -21}},
+		},
+		{
+			name: "empty",
+			a:    [][]int{},
+			b:    [][]int{},
+			want: [][]int{},
+		},
+	}
+
+	for _, mf := range multiplyFuncs {
+		for _, tt := range tests {
+			t.Run(mf.name+"/"+tt.name, func(t *testing.T) {
+				got, err := mf.fn(tt.a, tt.b)
+				if err != nil {
+					t.Fatalf("%s(%v, %v) returned error: %v", mf.name, tt.a, tt.b, err)
+				}
+
+				if !cmp.Equal(got, tt.want) {
+					t.Errorf("%s(%v, %v) = %v, want %v", mf.name, tt.a, tt.b, got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestMatrixMultiplicationIdentity(t *testing.T) {
+	const n = 5
+
+	m := make([][]int, n)
+	identity := make([][]int, n)
+	for i := range n {
+		m[i] = make([]int, n)
+		identity[i] = make([]int, n)
+		identity[i][i] = 1
+		for j := range n {
+			m[i][j] = i*n + j - 7
+		}
+	}
+
+	for _, mf := range multiplyFuncs {
+		for _, tt := range []struct {
+			name string
+			a, b [][]int
+		}{
+			{"identity on the left", identity, m},
+			{"identity on the right", m, identity},
+		} {
+			got, err := mf.fn(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("%s with %s returned error: %v", mf.name, tt.name, err)
+			}
+
+			if !cmp.Equal(got, m) {
+				t.Errorf("%s with %s = %v, want %v", mf.name, tt.name, got, m)
+			}
+		}
+	}
+}
+
+func TestMatrixMultiplicationErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		a    [][]int
+		b    [][]int
+	}{
+		{"inner dimension mismatch", [][]int{{1, 2}}, [][]int{{1, 2}}},
+		{"ragged first matrix", [][]int{{1, 2}, {3}}, [][]int{{1}, {2}}},
+		{"ragged second matrix", [][]int{{1, 2}}, [][]int{{1, 2}, {3}}},
+		{"empty second matrix", [][]int{{1}}, [][]int{}},
+	}
+
+	for _, mf := range multiplyFuncs {
+		for _, tt := range tests {
+			t.Run(mf.name+"/"+tt.name, func(t *testing.T) {
+				if got, err := mf.fn(tt.a, tt.b); err == nil {
+					t.Errorf("%s(%v, %v) = %v, want an error", mf.name, tt.a, tt.b, got)
+				}
+			})
+		}
+	}
+}
+
+func TestNaiveMatrixMultiplicationMatchesStandard(t *testing.T) {
+	// Non-square shapes catch any mix-up between the loop bounds.
+	for _, shape := range [][3]int{{1, 1, 1}, {3, 7, 2}, {8, 1, 8}, {17, 13, 11}} {
+		m, k, p := shape[0], shape[1], shape[2]
+		t.Run(fmt.Sprintf("%dx%d_times_%dx%d", m, k, k, p), func(t *testing.T) {
+			a := make([][]int, m)
+			for i := range a {
+				a[i] = make([]int, k)
+				for j := range a[i] {
+					a[i][j] = (i*31+j*17)%19 - 9
+				}
+			}
+
+			b := make([][]int, k)
+			for i := range b {
+				b[i] = make([]int, p)
+				for j := range b[i] {
+					b[i][j] = (i*13+j*29)%23 - 11
+				}
+			}
+
+			want, err := StandardMatrixMultiplication(a, b)
+			if err != nil {
+				t.Fatalf("StandardMatrixMultiplication() returned error: %v", err)
+			}
+
+			got, err := NaiveMatrixMultiplication(a, b)
+			if err != nil {
+				t.Fatalf("NaiveMatrixMultiplication() returned error: %v", err)
+			}
+
+			if !cmp.Equal(got, want) {
+				t.Errorf("NaiveMatrixMultiplication() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func BenchmarkMatrixMultiplication(b *testing.B) {
+	for _, n := range []int{10, 50, 100} {
+		m := make([][]int, n)
+		for i := range m {
+			m[i] = make([]int, n)
+			for j := range m[i] {
+				m[i][j] = (i*n + j) % 100
+			}
+		}
+
+		for _, mf := range multiplyFuncs {
+			b.Run(fmt.Sprintf("%s/size_%d", mf.name, n), func(b *testing.B) {
+				for b.Loop() {
+					_, _ = mf.fn(m, m)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import "fmt"
+
+// CircularDoublyLinkedList is a doubly linked list whose tail links forward to
+// the head and whose head links back to the tail, forming a ring. This suits
+// round-robin scheduling, where the next item to serve moves around the ring
+// with Rotate. Only the head is stored; the tail is always head.prev.
+type CircularDoublyLinkedList[T comparable] struct {
+	head *doublyLinkedNode[T] // The logical first node, nil when empty
+	size int                  // Number of elements for O(1) length operations
+}
+
+// NewCircularDoublyLinkedList creates a new empty circular doubly linked list.
+func NewCircularDoublyLinkedList[T comparable]() *CircularDoublyLinkedList[T] {
+	return &CircularDoublyLinkedList[T]{
+		head: nil,
+		size: 0,
+	}
+}
+
+// NewCircularDoublyLinkedListWithValues creates a new circular doubly linked
+// list with initial values.
+func NewCircularDoublyLinkedListWithValues[T comparable](values ...T) *CircularDoublyLinkedList[T] {
+	cdll := NewCircularDoublyLinkedList[T]()
+	for _, value := range values {
+		cdll.PushBack(value)
+	}
+
+	return cdll
+}
+
+// PushBack adds an element at the end of the ring, just before the head - O(1).
+func (cdll *CircularDoublyLinkedList[T]) PushBack(value T) {
+	cdll.insertBeforeHead(value)
+}
+
+// PushFront adds an element at the start of the ring and makes it the head - O(1).
+func (cdll *CircularDoublyLinkedList[T]) PushFront(value T) {
+	cdll.head = cdll.insertBeforeHead(value)
+}
+
+// insertBeforeHead links a new node between the tail and the head and
+// returns it. In an empty list the node links to itself and becomes the head.
+func (cdll *CircularDoublyLinkedList[T]) insertBeforeHead(value T) *doublyLinkedNode[T] {
+	newNode := newDoublyLinkedNode(value)
+
+	if cdll.head == nil {
+		newNode.next = newNode
+		newNode.prev = newNode
+		cdll.head = newNode
+	} else {
+		tail := cdll.head.prev
+		newNode.prev = tail
+		newNode.next = cdll.head
+		tail.next = newNode
+		cdll.head.prev = newNode
+	}
+
+	cdll.size++
+
+	return newNode
+}
+
+// PopFront removes and returns the head element - O(1). The next element
+// becomes the head.
+func (cdll *CircularDoublyLinkedList[T]) PopFront() (T, bool) {
+	if cdll.head == nil {
+		var zero T
+
+		return zero, false
+	}
+
+	node := cdll.head
+	cdll.head = node.next
+	cdll.unlink(node)
+
+	return node.value, true
+}
+
+// PopBack removes and returns the tail element - O(1).
+func (cdll *CircularDoublyLinkedList[T]) PopBack() (T, bool) {
+	if cdll.head == nil {
+		var zero T
+
+		return zero, false
+	}
+
+	node := cdll.head.prev
+	cdll.unlink(node)
+
+	return node.value, true
+}
+
+// unlink removes node from the ring. When it was the only node the list
+// becomes empty.
+func (cdll *CircularDoublyLinkedList[T]) unlink(node *doublyLinkedNode[T]) {
+	if cdll.size == 1 {
+		cdll.head = nil
+	} else {
+		node.prev.next = node.next
+		node.next.prev = node.prev
+	}
+
+	node.next = nil
+	node.prev = nil
+	cdll.size--
+}
+
+// Front returns the head element without removing it - O(1).
+func (cdll *CircularDoublyLinkedList[T]) Front() (T, bool) {
+	if cdll.head == nil {
+		var zero T
+
+		return zero, false
+	}
+
+	return cdll.head.value, true
+}
+
+// Back returns the tail element without removing it - O(1).
+func (cdll *CircularDoublyLinkedList[T]) Back() (T, bool) {
+	if cdll.head == nil {
+		var zero T
+
+		return zero, false
+	}
+
+	return cdll.head.prev.value, true
+}
+
+// Rotate advances the head by k positions around the ring, so the element k
+// places after the current head becomes the new head. A negative k moves the
+// head backward. Only k modulo the length steps are taken, so this is O(k)
+// and never more than O(n). Rotating an empty list is a no-op, and rotating
+// by a multiple of the length leaves the order unchanged.
+func (cdll *CircularDoublyLinkedList[T]) Rotate(k int) {
+	if cdll.size == 0 {
+		return
+	}
+
+	k %= cdll.size
+	for ; k > 0; k-- {
+		cdll.head = cdll.head.next
+	}
+
+	for ; k < 0; k++ {
+		cdll.head = cdll.head.prev
+	}
+}
+
+// Len returns the number of elements in the list - O(1).
+func (cdll *CircularDoublyLinkedList[T]) Len() int {
+	return cdll.size
+}
+
+// IsEmpty returns true if the list is empty - O(1).
+func (cdll *CircularDoublyLinkedList[T]) IsEmpty() bool {
+	return cdll.size == 0
+}
+
+// ToSlice returns one trip around the ring starting at the head - O(n).
+func (cdll *CircularDoublyLinkedList[T]) ToSlice() []T {
+	result := make([]T, cdll.size)
+	current := cdll.head
+
+	for i := range cdll.size {
+		result[i] = current.value
+		current = current.next
+	}
+
+	return result
+}
+
+// ToSliceReverse returns one trip around the ring backward, starting at the
+// tail - O(n).
+func (cdll *CircularDoublyLinkedList[T]) ToSliceReverse() []T {
+	result := make([]T, cdll.size)
+	if cdll.head == nil {
+		return result
+	}
+
+	current := cdll.head.prev
+	for i := range cdll.size {
+		result[i] = current.value
+		current = current.prev
+	}
+
+	return result
+}
+
+// String returns a string representation of the list.
+func (cdll *CircularDoublyLinkedList[T]) String() string {
+	return fmt.Sprintf("CircularDoublyLinkedList%v", cdll.ToSlice())
+}
+
+// RingIterator returns an iterator that starts at the head and keeps going
+// around the ring, wrapping from the tail back to the head, until Stop is
+// called. Modifying the list while iterating is not supported.
+func (cdll *CircularDoublyLinkedList[T]) RingIterator() *RingIterator[T] {
+	return &RingIterator[T]{
+		current: cdll.head,
+	}
+}
+
+// RingIterator iterates around a CircularDoublyLinkedList without end. It
+// implements Iterator, but HasNext only becomes false once Stop is called, or
+// immediately if the list was empty.
+type RingIterator[T comparable] struct {
+	current *doublyLinkedNode[T]
+}
+
+// HasNext returns true until the iterator is stopped or if the list is empty.
+func (it *RingIterator[T]) HasNext() bool {
+	return it.current != nil
+}
+
+// Next returns the current element and advances the iterator around the ring.
+func (it *RingIterator[T]) Next() (T, bool) {
+	if it.current == nil {
+		var zero T
+
+		return zero, false
+	}
+
+	value := it.current.value
+	it.current = it.current.next
+
+	return value, true
+}
+
+// Value returns the current element without advancing the iterator.
+func (it *RingIterator[T]) Value() T {
+	if it.current == nil {
+		var zero T
+
+		return zero
+	}
+
+	return it.current.value
+}
+
+// Stop ends the iteration. Afterward HasNext returns false and Next returns
+// the zero value and false.
+func (it *RingIterator[T]) Stop() {
+	it.current = nil
+}
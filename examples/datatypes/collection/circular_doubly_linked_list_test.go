@@ -0,0 +1,142 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCircularDoublyLinkedListPushPop(t *testing.T) {
+	cdll := NewCircularDoublyLinkedList[int]()
+	if !cdll.IsEmpty() || cdll.Len() != 0 {
+		t.Fatalf("NewCircularDoublyLinkedList() should create empty list, got len=%d, empty=%v", cdll.Len(), cdll.IsEmpty())
+	}
+
+	cdll.PushBack(2)
+	cdll.PushBack(3)
+	cdll.PushFront(1)
+
+	if got := cdll.ToSlice(); !cmp.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("ToSlice() = %v, want [1 2 3]", got)
+	}
+
+	if got := cdll.ToSliceReverse(); !cmp.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("ToSliceReverse() = %v, want [3 2 1]", got)
+	}
+
+	// The ring closes: the tail links forward to the head and back again.
+	if cdll.head.prev.next != cdll.head || cdll.head.prev.value != 3 {
+		t.Errorf("tail.next is not the head, or head.prev is not the tail")
+	}
+
+	if got, ok := cdll.PopFront(); !ok || got != 1 {
+		t.Errorf("PopFront() = (%d, %v), want (1, true)", got, ok)
+	}
+
+	if got, ok := cdll.PopBack(); !ok || got != 3 {
+		t.Errorf("PopBack() = (%d, %v), want (3, true)", got, ok)
+	}
+
+	if got, ok := cdll.Front(); !ok || got != 2 {
+		t.Errorf("Front() = (%d, %v), want (2, true)", got, ok)
+	}
+
+	if got, ok := cdll.Back(); !ok || got != 2 {
+		t.Errorf("Back() = (%d, %v), want (2, true)", got, ok)
+	}
+
+	if got, ok := cdll.PopBack(); !ok || got != 2 {
+		t.Errorf("PopBack() = (%d, %v), want (2, true)", got, ok)
+	}
+
+	if got, ok := cdll.PopFront(); ok {
+		t.Errorf("PopFront() on empty list = (%d, %v), want (0, false)", got, ok)
+	}
+
+	if got, ok := cdll.Back(); ok {
+		t.Errorf("Back() on empty list = (%d, %v), want (0, false)", got, ok)
+	}
+
+	cdll.PushBack(4)
+	if got := cdll.String(); got != "CircularDoublyLinkedList[4]" {
+		t.Errorf("String() = %q, want %q", got, "CircularDoublyLinkedList[4]")
+	}
+}
+
+func TestCircularDoublyLinkedListRotate(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		k      int
+		want   []int
+	}{
+		{"empty", []int{}, 3, []int{}},
+		{"single element", []int{1}, 5, []int{1}},
+		{"zero", []int{1, 2, 3, 4}, 0, []int{1, 2, 3, 4}},
+		{"by one", []int{1, 2, 3, 4}, 1, []int{2, 3, 4, 1}},
+		{"by three", []int{1, 2, 3, 4}, 3, []int{4, 1, 2, 3}},
+		{"by the length", []int{1, 2, 3, 4}, 4, []int{1, 2, 3, 4}},
+		{"by a multiple of the length", []int{1, 2, 3, 4}, 12, []int{1, 2, 3, 4}},
+		{"more than the length", []int{1, 2, 3, 4}, 6, []int{3, 4, 1, 2}},
+		{"backward", []int{1, 2, 3, 4}, -1, []int{4, 1, 2, 3}},
+		{"backward by a multiple of the length", []int{1, 2, 3, 4}, -8, []int{1, 2, 3, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cdll := NewCircularDoublyLinkedListWithValues(tt.values...)
+			cdll.Rotate(tt.k)
+
+			if got := cdll.ToSlice(); !cmp.Equal(got, tt.want) {
+				t.Errorf("Rotate(%d) of %v = %v, want %v", tt.k, tt.values, got, tt.want)
+			}
+
+			if got := cdll.ToSliceReverse(); !cmp.Equal(got, reversed(tt.want)) {
+				t.Errorf("Rotate(%d) of %v: ToSliceReverse() = %v, want %v", tt.k, tt.values, got, reversed(tt.want))
+			}
+		})
+	}
+}
+
+func TestCircularDoublyLinkedListRingIterator(t *testing.T) {
+	cdll := NewCircularDoublyLinkedListWithValues("a", "b", "c")
+
+	it := cdll.RingIterator()
+
+	var got []string
+	for it.HasNext() {
+		v, _ := it.Next()
+		got = append(got, v)
+
+		// The iterator keeps wrapping until it is stopped.
+		if len(got) == 7 {
+			it.Stop()
+		}
+	}
+
+	if want := []string{"a", "b", "c", "a", "b", "c", "a"}; !cmp.Equal(got, want) {
+		t.Errorf("RingIterator() = %v, want %v", got, want)
+	}
+
+	if v, ok := it.Next(); ok || v != "" {
+		t.Errorf("Next() after Stop() = (%q, %v), want (\"\", false)", v, ok)
+	}
+
+	if it := NewCircularDoublyLinkedList[string]().RingIterator(); it.HasNext() {
+		t.Errorf("RingIterator() of an empty list HasNext() = true, want false")
+	}
+}
@@ -27,6 +27,29 @@ The package provides two main generic collection types:
   - DoublyLinkedList[T comparable]: A doubly-linked list with O(1) operations
     at both ends and optimized bidirectional traversal.
 
+Built on top of these:
+
+  - LRUCache[K comparable, V any]: A fixed capacity least recently used cache
+    combining a map with a DoublyLinkedList for O(1) Get and Put.
+
+  - Stack[T comparable] and Queue[T comparable]: LIFO and FIFO containers
+    with O(1) Push/Pop/Peek and Enqueue/Dequeue/Peek.
+
+  - CircularDoublyLinkedList[T comparable]: A ring whose tail links back to
+    the head, with O(k) Rotate and an endless RingIterator for round-robin
+    scheduling.
+
+  - SyncDoublyLinkedList[T comparable]: A DoublyLinkedList guarded by a
+    sync.RWMutex so it can be shared across goroutines, e.g., as a work queue.
+
+Alongside them are ordered collections:
+
+  - SortedMap[K cmp.Ordered, V any]: An ordered symbol table backed by an AVL
+    tree with O(log n) updates, Floor and Ceiling lookups, and range queries.
+
+  - PriorityQueue[T any]: A binary heap ordered by a less function with
+    O(log n) Push and Pop and O(1) Peek.
+
 # Usage Examples
 
 Creating and using a generic LinkedList:
@@ -64,6 +87,7 @@ LinkedList[T]:
   - PushBack: O(1) with tail pointer
   - PopBack: O(n) - requires full traversal
   - Find/Contains: O(n)
+  - Reverse: O(n) in place
   - Len(): O(1) with size tracking
 
 DoublyLinkedList[T]:
@@ -71,6 +95,14 @@ DoublyLinkedList[T]:
   - At(index): O(min(index, size-index))
   - Insert/Remove: O(min(index, size-index))
   - Find/Contains: O(n)
+  - Reverse: O(n) in place
   - Reverse iteration: O(1) per step
+  - Filter/Map: O(n), returning a new list
+  - RemoveValue: O(n) to find, O(1) to unlink
+  - Equal: O(1) for different lengths, otherwise O(n)
+
+LRUCache[K, V]:
+  - Get/Put: O(1)
+  - Eviction of the least recently used entry: O(1)
 */
 package collection
@@ -301,6 +301,21 @@ func (dll *DoublyLinkedList[T]) Remove(index int) (T, error) {
 	return value, nil
 }
 
+// RemoveValue removes the first occurrence of the value, returning whether
+// one was found - O(n) to find it, O(1) to unlink it. Unlike a Find followed
+// by Remove, the list is only traversed once.
+func (dll *DoublyLinkedList[T]) RemoveValue(value T) bool {
+	for current := dll.head; current != nil; current = current.next {
+		if current.value == value {
+			dll.removeNode(current)
+
+			return true
+		}
+	}
+
+	return false
+}
+
 // Find returns the index of the first occurrence of the value, or -1 if not found - O(n).
 func (dll *DoublyLinkedList[T]) Find(value T) int {
 	current := dll.head
@@ -319,6 +334,23 @@ func (dll *DoublyLinkedList[T]) Contains(value T) bool {
 	return dll.Find(value) != -1
 }
 
+// Equal reports whether other holds the same values in the same order - O(n).
+// Lists of different lengths are unequal without walking either list, and
+// the walk stops at the first difference.
+func (dll *DoublyLinkedList[T]) Equal(other *DoublyLinkedList[T]) bool {
+	if other == nil || dll.size != other.size {
+		return false
+	}
+
+	for a, b := dll.head, other.head; a != nil; a, b = a.next, b.next {
+		if a.value != b.value {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Len returns the number of elements in the list - O(1).
 func (dll *DoublyLinkedList[T]) Len() int {
 	return dll.size
@@ -336,6 +368,44 @@ func (dll *DoublyLinkedList[T]) Clear() {
 	dll.size = 0
 }
 
+// Reverse reverses the order of the list in place by swapping the next and
+// prev pointers of every node, then swapping head and tail - O(n) time,
+// O(1) space.
+func (dll *DoublyLinkedList[T]) Reverse() {
+	for current := dll.head; current != nil; current = current.prev {
+		current.next, current.prev = current.prev, current.next
+	}
+
+	dll.head, dll.tail = dll.tail, dll.head
+}
+
+// Filter returns a new list holding the elements for which pred returns true,
+// in their original order - O(n). The original list is unchanged.
+func (dll *DoublyLinkedList[T]) Filter(pred func(T) bool) *DoublyLinkedList[T] {
+	result := NewDoublyLinkedList[T]()
+	for current := dll.head; current != nil; current = current.next {
+		if pred(current.value) {
+			result.PushBack(current.value)
+		}
+	}
+
+	return result
+}
+
+// Map returns a new list holding f applied to each element of dll, in order -
+// O(n). The original list is unchanged.
+//
+// Map is a function rather than a method because Go methods can't introduce
+// a new type parameter such as U.
+func Map[T, U comparable](dll *DoublyLinkedList[T], f func(T) U) *DoublyLinkedList[U] {
+	result := NewDoublyLinkedList[U]()
+	for current := dll.head; current != nil; current = current.next {
+		result.PushBack(f(current.value))
+	}
+
+	return result
+}
+
 // ToSlice converts the doubly linked list to a slice - O(n).
 func (dll *DoublyLinkedList[T]) ToSlice() []T {
 	result := make([]T, dll.size)
@@ -503,6 +573,37 @@ func (dll *DoublyLinkedList[T]) insertBefore(node *doublyLinkedNode[T], value T)
 	return newNode
 }
 
+// pushFrontNode adds an element to the beginning of the list and returns the
+// node holding it - O(1).
+func (dll *DoublyLinkedList[T]) pushFrontNode(value T) *doublyLinkedNode[T] {
+	dll.PushFront(value)
+
+	return dll.head
+}
+
+// moveToFront moves the specified node to the beginning of the list - O(1).
+// This is only possible with node references and doubly linked structure.
+func (dll *DoublyLinkedList[T]) moveToFront(node *doublyLinkedNode[T]) {
+	if node == nil || node == dll.head {
+		return
+	}
+
+	// Unlink the node. It isn't the head, so node.prev is never nil.
+	node.prev.next = node.next
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		// Moving tail
+		dll.tail = node.prev
+	}
+
+	// Relink it in front of the current head.
+	node.prev = nil
+	node.next = dll.head
+	dll.head.prev = node
+	dll.head = node
+}
+
 // removeNode removes the specified node from the list - O(1).
 // This is only possible with node references and doubly linked structure.
 func (dll *DoublyLinkedList[T]) removeNode(node *doublyLinkedNode[T]) T {
@@ -15,6 +15,8 @@
 package collection
 
 import (
+	"slices"
+	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -350,6 +352,46 @@ func TestDoublyLinkedListRemove(t *testing.T) {
 	})
 }
 
+func TestDoublyLinkedListRemoveValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		remove int
+		want   []int
+		found  bool
+	}{
+		{"empty", []int{}, 1, []int{}, false},
+		{"not present", []int{1, 2, 3}, 4, []int{1, 2, 3}, false},
+		{"only element", []int{1}, 1, []int{}, true},
+		{"head", []int{1, 2, 3}, 1, []int{2, 3}, true},
+		{"tail", []int{1, 2, 3}, 3, []int{1, 2}, true},
+		{"middle", []int{1, 2, 3}, 2, []int{1, 3}, true},
+		{"first of duplicates", []int{1, 2, 1, 2}, 2, []int{1, 1, 2}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dll := DoublyFromSlice(tt.values)
+
+			if got := dll.RemoveValue(tt.remove); got != tt.found {
+				t.Errorf("RemoveValue(%d) = %v, want %v", tt.remove, got, tt.found)
+			}
+
+			if got := dll.ToSlice(); !cmp.Equal(got, tt.want) {
+				t.Errorf("After RemoveValue(%d): ToSlice() = %v, want %v", tt.remove, got, tt.want)
+			}
+
+			if got := dll.ToSliceReverse(); !cmp.Equal(got, reversed(tt.want)) {
+				t.Errorf("After RemoveValue(%d): ToSliceReverse() = %v, want %v", tt.remove, got, reversed(tt.want))
+			}
+
+			if dll.Len() != len(tt.want) {
+				t.Errorf("After RemoveValue(%d): Len() = %d, want %d", tt.remove, dll.Len(), len(tt.want))
+			}
+		})
+	}
+}
+
 func TestDoublyLinkedListFind(t *testing.T) {
 	dll := DoublyFromSlice([]int{10, 20, 30, 20, 40})
 
@@ -398,6 +440,45 @@ func TestDoublyLinkedListContains(t *testing.T) {
 	}
 }
 
+func TestDoublyLinkedListEqual(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     *DoublyLinkedList[int]
+		b     *DoublyLinkedList[int]
+		equal bool
+	}{
+		{"both empty", NewDoublyLinkedList[int](), NewDoublyLinkedList[int](), true},
+		{"same values", DoublyFromSlice([]int{1, 2, 3}), DoublyFromSlice([]int{1, 2, 3}), true},
+		{"different lengths", DoublyFromSlice([]int{1, 2, 3}), DoublyFromSlice([]int{1, 2}), false},
+		{"empty and non-empty", NewDoublyLinkedList[int](), DoublyFromSlice([]int{1}), false},
+		{"differ at head", DoublyFromSlice([]int{0, 2, 3}), DoublyFromSlice([]int{1, 2, 3}), false},
+		{"differ at tail", DoublyFromSlice([]int{1, 2, 3}), DoublyFromSlice([]int{1, 2, 4}), false},
+		{"same values in a different order", DoublyFromSlice([]int{1, 2, 3}), DoublyFromSlice([]int{3, 2, 1}), false},
+		{"nil other", DoublyFromSlice([]int{1}), nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Equal(tt.b); got != tt.equal {
+				t.Errorf("%v.Equal(%v) = %v, want %v", tt.a, tt.b, got, tt.equal)
+			}
+
+			if tt.b == nil {
+				return
+			}
+
+			if got := tt.b.Equal(tt.a); got != tt.equal {
+				t.Errorf("%v.Equal(%v) = %v, want %v", tt.b, tt.a, got, tt.equal)
+			}
+		})
+	}
+
+	dll := DoublyFromSlice([]int{1, 2, 3})
+	if !dll.Equal(dll) {
+		t.Errorf("%v.Equal(itself) = false, want true", dll)
+	}
+}
+
 func TestDoublyLinkedListClear(t *testing.T) {
 	dll := DoublyFromSlice([]int{1, 2, 3, 4, 5})
 	dll.Clear()
@@ -438,6 +519,133 @@ func TestDoublyLinkedListToSliceReverse(t *testing.T) {
 	}
 }
 
+func TestDoublyLinkedListReverse(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"single element", []int{42}, []int{42}},
+		{"two elements", []int{10, 20}, []int{20, 10}},
+		{"multiple elements", []int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dll := DoublyFromSlice(tt.values)
+
+			if got := dll.ToSliceReverse(); !cmp.Equal(got, tt.want) {
+				t.Errorf("Before Reverse(): ToSliceReverse() = %v, want %v", got, tt.want)
+			}
+
+			dll.Reverse()
+
+			if got := dll.ToSlice(); !cmp.Equal(got, tt.want) {
+				t.Errorf("After Reverse(): ToSlice() = %v, want %v", got, tt.want)
+			}
+
+			if got := dll.ToSliceReverse(); !cmp.Equal(got, tt.values) {
+				t.Errorf("After Reverse(): ToSliceReverse() = %v, want %v", got, tt.values)
+			}
+
+			if dll.Len() != len(tt.want) {
+				t.Errorf("After Reverse(): Len() = %d, want %d", dll.Len(), len(tt.want))
+			}
+
+			// Reversing twice restores the original order.
+			dll.Reverse()
+
+			if got := dll.ToSlice(); !cmp.Equal(got, tt.values) {
+				t.Errorf("After Reverse() twice: ToSlice() = %v, want %v", got, tt.values)
+			}
+		})
+	}
+}
+
+func TestDoublyLinkedListFilter(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	tests := []struct {
+		name   string
+		values []int
+		pred   func(int) bool
+		want   []int
+	}{
+		{"empty", []int{}, isEven, []int{}},
+		{"keep some", []int{1, 2, 3, 4, 5, 6}, isEven, []int{2, 4, 6}},
+		{"keep all", []int{2, 4, 6}, isEven, []int{2, 4, 6}},
+		{"drop everything", []int{1, 3, 5}, isEven, []int{}},
+		{"drop everything with false predicate", []int{1, 2, 3}, func(int) bool { return false }, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dll := DoublyFromSlice(tt.values)
+			got := dll.Filter(tt.pred)
+
+			if !cmp.Equal(got.ToSlice(), tt.want) {
+				t.Errorf("Filter() = %v, want %v", got.ToSlice(), tt.want)
+			}
+
+			if !cmp.Equal(got.ToSliceReverse(), reversed(tt.want)) {
+				t.Errorf("Filter().ToSliceReverse() = %v, want %v", got.ToSliceReverse(), reversed(tt.want))
+			}
+
+			if got.Len() != len(tt.want) {
+				t.Errorf("Filter().Len() = %d, want %d", got.Len(), len(tt.want))
+			}
+
+			if !cmp.Equal(dll.ToSlice(), tt.values) {
+				t.Errorf("Filter() modified the original list: got %v, want %v", dll.ToSlice(), tt.values)
+			}
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   []string
+	}{
+		{"empty", []int{}, []string{}},
+		{"single element", []int{7}, []string{"7"}},
+		{"multiple elements", []int{1, 22, 333}, []string{"1", "22", "333"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dll := DoublyFromSlice(tt.values)
+			got := Map(dll, strconv.Itoa)
+
+			if !cmp.Equal(got.ToSlice(), tt.want) {
+				t.Errorf("Map() = %v, want %v", got.ToSlice(), tt.want)
+			}
+
+			if !cmp.Equal(got.ToSliceReverse(), reversed(tt.want)) {
+				t.Errorf("Map().ToSliceReverse() = %v, want %v", got.ToSliceReverse(), reversed(tt.want))
+			}
+
+			if got.Len() != len(tt.want) {
+				t.Errorf("Map().Len() = %d, want %d", got.Len(), len(tt.want))
+			}
+
+			if !cmp.Equal(dll.ToSlice(), tt.values) {
+				t.Errorf("Map() modified the original list: got %v, want %v", dll.ToSlice(), tt.values)
+			}
+		})
+	}
+}
+
+// reversed returns a reversed copy of s.
+func reversed[T any](s []T) []T {
+	r := slices.Clone(s)
+	slices.Reverse(r)
+
+	return r
+}
+
 func TestDoublyLinkedListIterator(t *testing.T) {
 	dll := DoublyFromSlice([]int{1, 2, 3})
 	it := dll.Iterator()
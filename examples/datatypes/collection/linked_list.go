@@ -283,6 +283,22 @@ func (ll *LinkedList[T]) Clear() {
 	ll.size = 0
 }
 
+// Reverse reverses the order of the list in place by relinking each node to
+// point at its predecessor - O(n) time, O(1) space.
+func (ll *LinkedList[T]) Reverse() {
+	var prev *node[T]
+	current := ll.head
+
+	for current != nil {
+		next := current.next
+		current.next = prev
+		prev = current
+		current = next
+	}
+
+	ll.head, ll.tail = ll.tail, ll.head
+}
+
 // ToSlice converts the linked list to a slice - O(n).
 func (ll *LinkedList[T]) ToSlice() []T {
 	result := make([]T, ll.size)
@@ -296,6 +312,37 @@ func (ll *LinkedList[T]) ToSlice() []T {
 	return result
 }
 
+// validate checks the invariants the O(1) operations rely on: head and tail
+// are either both nil or both set, the tail is the last node reachable from
+// the head, and size matches the number of nodes - O(n). Every mutating
+// method must leave the list in a state that passes.
+func (ll *LinkedList[T]) validate() error {
+	if (ll.head == nil) != (ll.tail == nil) {
+		return fmt.Errorf("head is nil = %v but tail is nil = %v", ll.head == nil, ll.tail == nil)
+	}
+
+	count := 0
+	var last *node[T]
+	for current := ll.head; current != nil; current = current.next {
+		count++
+		// Stop rather than loop forever if the list has a cycle.
+		if count > ll.size {
+			return fmt.Errorf("more nodes than size %d", ll.size)
+		}
+		last = current
+	}
+
+	if count != ll.size {
+		return fmt.Errorf("size is %d but found %d nodes", ll.size, count)
+	}
+
+	if last != ll.tail {
+		return errors.New("tail is not the last node")
+	}
+
+	return nil
+}
+
 // String returns a string representation of the list.
 func (ll *LinkedList[T]) String() string {
 	return fmt.Sprintf("LinkedList%v", ll.ToSlice())
@@ -172,6 +172,103 @@ func TestLinkedListPopBack(t *testing.T) {
 	}
 }
 
+func TestLinkedListValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(ll *LinkedList[int])
+		want   []int
+	}{
+		{"PushFront", func(ll *LinkedList[int]) { ll.PushFront(0) }, []int{0, 1, 2, 3}},
+		{"PushBack", func(ll *LinkedList[int]) { ll.PushBack(4) }, []int{1, 2, 3, 4}},
+		{"PopFront", func(ll *LinkedList[int]) { ll.PopFront() }, []int{2, 3}},
+		{"PopBack", func(ll *LinkedList[int]) { ll.PopBack() }, []int{1, 2}},
+		{"Insert at head", func(ll *LinkedList[int]) { _ = ll.Insert(0, 0) }, []int{0, 1, 2, 3}},
+		{"Insert in middle", func(ll *LinkedList[int]) { _ = ll.Insert(2, 9) }, []int{1, 2, 9, 3}},
+		{"Insert at tail", func(ll *LinkedList[int]) { _ = ll.Insert(3, 4) }, []int{1, 2, 3, 4}},
+		{"Remove head", func(ll *LinkedList[int]) { _, _ = ll.Remove(0) }, []int{2, 3}},
+		{"Remove middle", func(ll *LinkedList[int]) { _, _ = ll.Remove(1) }, []int{1, 3}},
+		{"Remove tail", func(ll *LinkedList[int]) { _, _ = ll.Remove(2) }, []int{1, 2}},
+		{"Reverse", func(ll *LinkedList[int]) { ll.Reverse() }, []int{3, 2, 1}},
+		{"Clear", func(ll *LinkedList[int]) { ll.Clear() }, []int{}},
+		{
+			"PopFront until empty",
+			func(ll *LinkedList[int]) {
+				for !ll.IsEmpty() {
+					ll.PopFront()
+				}
+			},
+			[]int{},
+		},
+		{
+			"PopBack until empty",
+			func(ll *LinkedList[int]) {
+				for !ll.IsEmpty() {
+					ll.PopBack()
+				}
+			},
+			[]int{},
+		},
+		{
+			"Remove until empty",
+			func(ll *LinkedList[int]) {
+				for !ll.IsEmpty() {
+					_, _ = ll.Remove(ll.Len() - 1)
+				}
+			},
+			[]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := FromSlice([]int{1, 2, 3})
+			if err := ll.validate(); err != nil {
+				t.Fatalf("FromSlice() produced an invalid list: %v", err)
+			}
+
+			tt.mutate(ll)
+
+			if err := ll.validate(); err != nil {
+				t.Errorf("After %s: validate() = %v", tt.name, err)
+			}
+
+			if got := ll.ToSlice(); !cmp.Equal(got, tt.want) {
+				t.Errorf("After %s: ToSlice() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLinkedListPushBackAfterEmptying is a regression test: if PopBack did
+// not reset tail to nil when removing the last element, the next PushBack
+// would link the new node onto the stale tail instead of setting the head.
+func TestLinkedListPushBackAfterEmptying(t *testing.T) {
+	ll := FromSlice([]int{1})
+
+	if val, ok := ll.PopBack(); !ok || val != 1 {
+		t.Fatalf("PopBack() = (%d, %v), want (1, true)", val, ok)
+	}
+
+	if err := ll.validate(); err != nil {
+		t.Fatalf("After emptying with PopBack(): validate() = %v", err)
+	}
+
+	ll.PushBack(2)
+	ll.PushBack(3)
+
+	if err := ll.validate(); err != nil {
+		t.Errorf("After PushBack() on an emptied list: validate() = %v", err)
+	}
+
+	if got := ll.ToSlice(); !cmp.Equal(got, []int{2, 3}) {
+		t.Errorf("After PushBack() on an emptied list: ToSlice() = %v, want [2 3]", got)
+	}
+
+	if got, _ := ll.Front(); got != 2 {
+		t.Errorf("After PushBack() on an emptied list: Front() = %d, want 2", got)
+	}
+}
+
 func TestLinkedListFront(t *testing.T) {
 	// Empty list
 	ll := NewLinkedList[int]()
@@ -379,6 +476,50 @@ func TestLinkedListClear(t *testing.T) {
 	}
 }
 
+func TestLinkedListReverse(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"single element", []int{42}, []int{42}},
+		{"two elements", []int{10, 20}, []int{20, 10}},
+		{"multiple elements", []int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ll := FromSlice(tt.values)
+			ll.Reverse()
+
+			if got := ll.ToSlice(); !cmp.Equal(got, tt.want) {
+				t.Errorf("After Reverse(): ToSlice() = %v, want %v", got, tt.want)
+			}
+
+			if ll.Len() != len(tt.want) {
+				t.Errorf("After Reverse(): Len() = %d, want %d", ll.Len(), len(tt.want))
+			}
+
+			// Head and tail must be updated so the O(1) operations still work.
+			if len(tt.want) > 0 {
+				if got, _ := ll.Front(); got != tt.want[0] {
+					t.Errorf("After Reverse(): Front() = %d, want %d", got, tt.want[0])
+				}
+
+				if got, _ := ll.Back(); got != tt.want[len(tt.want)-1] {
+					t.Errorf("After Reverse(): Back() = %d, want %d", got, tt.want[len(tt.want)-1])
+				}
+			}
+
+			ll.PushBack(99)
+			if got, want := ll.ToSlice(), append(tt.want, 99); !cmp.Equal(got, want) {
+				t.Errorf("After Reverse() and PushBack(99): ToSlice() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
 func TestLinkedListIterator(t *testing.T) {
 	ll := FromSlice([]int{1, 2, 3})
 	it := ll.Iterator()
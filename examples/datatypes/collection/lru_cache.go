@@ -0,0 +1,102 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+// lruEntry is the key/value pair stored in the recency list of an LRUCache.
+// The key is kept so the map entry can be deleted on eviction.
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// LRUCache is a fixed capacity cache that evicts the least recently used
+// entry when a new key is added to a full cache. Both Get and Put are O(1):
+// the map finds the list node for a key in O(1), and the DoublyLinkedList
+// moves that node to the front or removes the tail in O(1).
+//
+// The list holds pointers to the entries so that values of any type can be
+// stored even though the list itself requires comparable elements.
+type LRUCache[K comparable, V any] struct {
+	capacity int
+	items    map[K]*doublyLinkedNode[*lruEntry[K, V]]
+	order    *DoublyLinkedList[*lruEntry[K, V]] // Most recently used at the front
+}
+
+// NewLRUCache creates an empty LRUCache that holds at most capacity entries.
+// A capacity less than 1 is treated as 1.
+func NewLRUCache[K comparable, V any](capacity int) *LRUCache[K, V] {
+	capacity = max(capacity, 1)
+
+	return &LRUCache[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*doublyLinkedNode[*lruEntry[K, V]], capacity),
+		order:    NewDoublyLinkedList[*lruEntry[K, V]](),
+	}
+}
+
+// Get returns the value stored for key and marks it as the most recently
+// used - O(1). The bool is false if the key is not in the cache.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	node, ok := c.items[key]
+	if !ok {
+		var zero V
+
+		return zero, false
+	}
+
+	c.order.moveToFront(node)
+
+	return node.value.value, true
+}
+
+// Put stores value for key and marks it as the most recently used - O(1).
+// If the key is new and the cache is full, the least recently used entry is
+// evicted first.
+func (c *LRUCache[K, V]) Put(key K, value V) {
+	if node, ok := c.items[key]; ok {
+		node.value.value = value
+		c.order.moveToFront(node)
+
+		return
+	}
+
+	if c.order.Len() >= c.capacity {
+		// The tail is the least recently used entry.
+		evicted := c.order.removeNode(c.order.tail)
+		delete(c.items, evicted.key)
+	}
+
+	c.items[key] = c.order.pushFrontNode(&lruEntry[K, V]{key: key, value: value})
+}
+
+// Len returns the number of entries in the cache - O(1).
+func (c *LRUCache[K, V]) Len() int {
+	return c.order.Len()
+}
+
+// Capacity returns the maximum number of entries the cache holds - O(1).
+func (c *LRUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Keys returns the keys in the cache from most to least recently used - O(n).
+func (c *LRUCache[K, V]) Keys() []K {
+	keys := make([]K, 0, c.order.Len())
+	for node := c.order.head; node != nil; node = node.next {
+		keys = append(keys, node.value.key)
+	}
+
+	return keys
+}
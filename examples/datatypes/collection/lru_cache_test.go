@@ -0,0 +1,177 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLRUCacheGetPut(t *testing.T) {
+	c := NewLRUCache[string, int](2)
+
+	if _, ok := c.Get("missing"); ok {
+		t.Errorf("Get(missing) on empty cache should return false")
+	}
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+
+	if got, ok := c.Get("a"); !ok || got != 1 {
+		t.Errorf("Get(a) = %d, %v, want 1, true", got, ok)
+	}
+
+	if got, ok := c.Get("b"); !ok || got != 2 {
+		t.Errorf("Get(b) = %d, %v, want 2, true", got, ok)
+	}
+
+	if c.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", c.Len())
+	}
+}
+
+func TestLRUCacheEvictionOrder(t *testing.T) {
+	c := NewLRUCache[int, string](3)
+	c.Put(1, "one")
+	c.Put(2, "two")
+	c.Put(3, "three")
+
+	// 1 is the least recently used and is evicted first.
+	c.Put(4, "four")
+	if _, ok := c.Get(1); ok {
+		t.Errorf("Get(1) after eviction should return false")
+	}
+
+	if want := []int{4, 3, 2}; !cmp.Equal(c.Keys(), want) {
+		t.Errorf("Keys() = %v, want %v", c.Keys(), want)
+	}
+
+	// Then 2, then 3.
+	c.Put(5, "five")
+	c.Put(6, "six")
+	if want := []int{6, 5, 4}; !cmp.Equal(c.Keys(), want) {
+		t.Errorf("Keys() = %v, want %v", c.Keys(), want)
+	}
+
+	if c.Len() != c.Capacity() {
+		t.Errorf("Len() = %d, want capacity %d", c.Len(), c.Capacity())
+	}
+}
+
+func TestLRUCacheRecencyUpdates(t *testing.T) {
+	t.Run("get refreshes recency", func(t *testing.T) {
+		c := NewLRUCache[string, int](2)
+		c.Put("a", 1)
+		c.Put("b", 2)
+
+		// Touching a makes b the least recently used.
+		_, _ = c.Get("a")
+		c.Put("c", 3)
+
+		if _, ok := c.Get("b"); ok {
+			t.Errorf("Get(b) should have been evicted")
+		}
+
+		if _, ok := c.Get("a"); !ok {
+			t.Errorf("Get(a) should still be cached")
+		}
+	})
+
+	t.Run("put on existing key updates value and recency", func(t *testing.T) {
+		c := NewLRUCache[string, int](2)
+		c.Put("a", 1)
+		c.Put("b", 2)
+		c.Put("a", 10)
+
+		if c.Len() != 2 {
+			t.Errorf("Len() after updating a key = %d, want 2", c.Len())
+		}
+
+		c.Put("c", 3)
+
+		if got, ok := c.Get("a"); !ok || got != 10 {
+			t.Errorf("Get(a) = %d, %v, want 10, true", got, ok)
+		}
+
+		if _, ok := c.Get("b"); ok {
+			t.Errorf("Get(b) should have been evicted")
+		}
+	})
+
+	t.Run("tail moved to front", func(t *testing.T) {
+		c := NewLRUCache[int, int](3)
+		c.Put(1, 1)
+		c.Put(2, 2)
+		c.Put(3, 3)
+		_, _ = c.Get(1)
+
+		if want := []int{1, 3, 2}; !cmp.Equal(c.Keys(), want) {
+			t.Errorf("Keys() = %v, want %v", c.Keys(), want)
+		}
+	})
+}
+
+func TestLRUCacheMinimumCapacity(t *testing.T) {
+	c := NewLRUCache[int, int](0)
+	if c.Capacity() != 1 {
+		t.Errorf("Capacity() = %d, want 1", c.Capacity())
+	}
+
+	c.Put(1, 1)
+	c.Put(2, 2)
+
+	if want := []int{2}; !cmp.Equal(c.Keys(), want) {
+		t.Errorf("Keys() = %v, want %v", c.Keys(), want)
+	}
+}
+
+func TestLRUCacheNonComparableValues(t *testing.T) {
+	c := NewLRUCache[string, []int](2)
+	c.Put("a", []int{1, 2, 3})
+
+	got, ok := c.Get("a")
+	if !ok || !cmp.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Get(a) = %v, %v, want [1 2 3], true", got, ok)
+	}
+}
+
+// BenchmarkLRUCache shows that Get and Put take the same time regardless of
+// how many entries the cache holds.
+func BenchmarkLRUCache(b *testing.B) {
+	for _, size := range []int{100, 10000, 1000000} {
+		c := NewLRUCache[int, int](size)
+		for i := range size {
+			c.Put(i, i)
+		}
+
+		b.Run(fmt.Sprintf("Get_size_%d", size), func(b *testing.B) {
+			i := 0
+			for b.Loop() {
+				_, _ = c.Get(i % size)
+				i++
+			}
+		})
+
+		b.Run(fmt.Sprintf("Put_size_%d", size), func(b *testing.B) {
+			i := size
+			for b.Loop() {
+				c.Put(i, i)
+				i++
+			}
+		})
+	}
+}
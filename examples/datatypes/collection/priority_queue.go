@@ -0,0 +1,134 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+// PriorityQueue is a binary heap ordered by a caller supplied less function.
+// The element for which less reports true against every other element is
+// always at the front, so with cmp.Less it is a min-heap and with the
+// arguments reversed it is a max-heap. Push and Pop are O(log n) since an
+// element moves at most the height of the heap, and Peek is O(1).
+type PriorityQueue[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+// NewPriorityQueue creates an empty PriorityQueue ordered by less.
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		items: nil,
+		less:  less,
+	}
+}
+
+// NewPriorityQueueFromSlice creates a PriorityQueue holding a copy of values
+// ordered by less. Building the heap bottom-up is O(n), cheaper than the
+// O(n log n) of pushing the values one at a time.
+func NewPriorityQueueFromSlice[T any](values []T, less func(a, b T) bool) *PriorityQueue[T] {
+	pq := &PriorityQueue[T]{
+		items: make([]T, len(values)),
+		less:  less,
+	}
+	copy(pq.items, values)
+
+	// Sift down every internal node, starting from the last one.
+	for i := len(pq.items)/2 - 1; i >= 0; i-- {
+		pq.down(i)
+	}
+
+	return pq
+}
+
+// Len returns the number of elements in the queue - O(1).
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.items)
+}
+
+// Push adds value to the queue - O(log n).
+func (pq *PriorityQueue[T]) Push(value T) {
+	pq.items = append(pq.items, value)
+	pq.up(len(pq.items) - 1)
+}
+
+// Pop removes and returns the front element - O(log n). The bool is false if
+// the queue is empty.
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	var zero T
+	if len(pq.items) == 0 {
+		return zero, false
+	}
+
+	last := len(pq.items) - 1
+	front := pq.items[0]
+	pq.items[0] = pq.items[last]
+	pq.items[last] = zero // Release the reference for the garbage collector
+	pq.items = pq.items[:last]
+
+	if last > 0 {
+		pq.down(0)
+	}
+
+	return front, true
+}
+
+// Peek returns the front element without removing it - O(1). The bool is
+// false if the queue is empty.
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	if len(pq.items) == 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	return pq.items[0], true
+}
+
+// up moves the element at index i toward the root until its parent is not
+// greater than it.
+func (pq *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(pq.items[i], pq.items[parent]) {
+			return
+		}
+
+		pq.items[i], pq.items[parent] = pq.items[parent], pq.items[i]
+		i = parent
+	}
+}
+
+// down moves the element at index i toward the leaves until neither child
+// is less than it.
+func (pq *PriorityQueue[T]) down(i int) {
+	n := len(pq.items)
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+
+		if left < n && pq.less(pq.items[left], pq.items[smallest]) {
+			smallest = left
+		}
+
+		if right < n && pq.less(pq.items[right], pq.items[smallest]) {
+			smallest = right
+		}
+
+		if smallest == i {
+			return
+		}
+
+		pq.items[i], pq.items[smallest] = pq.items[smallest], pq.items[i]
+		i = smallest
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func intLess(a, b int) bool { return a < b }
+
+// drain pops every element from the queue in order.
+func drain[T any](pq *PriorityQueue[T]) []T {
+	out := []T{}
+	for pq.Len() > 0 {
+		v, _ := pq.Pop()
+		out = append(out, v)
+	}
+
+	return out
+}
+
+func TestPriorityQueuePushPop(t *testing.T) {
+	pq := NewPriorityQueue(intLess)
+
+	if _, ok := pq.Pop(); ok {
+		t.Errorf("Pop() on empty queue should return false")
+	}
+
+	if _, ok := pq.Peek(); ok {
+		t.Errorf("Peek() on empty queue should return false")
+	}
+
+	for _, v := range []int{5, 3, 8, 1, 9, 1, 4} {
+		pq.Push(v)
+	}
+
+	if got := pq.Len(); got != 7 {
+		t.Errorf("Len() = %d, want 7", got)
+	}
+
+	if got, ok := pq.Peek(); !ok || got != 1 {
+		t.Errorf("Peek() = %d, %v, want 1, true", got, ok)
+	}
+
+	if got, want := drain(pq), []int{1, 1, 3, 4, 5, 8, 9}; !cmp.Equal(got, want) {
+		t.Errorf("popped %v, want %v", got, want)
+	}
+}
+
+func TestPriorityQueueMaxHeap(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b string) bool { return a > b })
+	for _, v := range []string{"pear", "apple", "zucchini", "fig"} {
+		pq.Push(v)
+	}
+
+	if got, want := drain(pq), []string{"zucchini", "pear", "fig", "apple"}; !cmp.Equal(got, want) {
+		t.Errorf("popped %v, want %v", got, want)
+	}
+}
+
+func TestPriorityQueueFromSlice(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 17, 1000} {
+		t.Run(fmt.Sprintf("size_%d", size), func(t *testing.T) {
+			values := make([]int, size)
+			for i := range values {
+				values[i] = (i*7919 + 13) % 101
+			}
+
+			original := slices.Clone(values)
+			pq := NewPriorityQueueFromSlice(values, intLess)
+
+			// Interleave pushes with the heapified values.
+			pq.Push(-1)
+			pq.Push(200)
+
+			want := append(slices.Clone(values), -1, 200)
+			slices.Sort(want)
+
+			if got := drain(pq); !cmp.Equal(got, want) {
+				t.Errorf("popped values are not in sorted order")
+			}
+
+			if !cmp.Equal(values, original) {
+				t.Errorf("NewPriorityQueueFromSlice modified its input")
+			}
+		})
+	}
+}
+
+func BenchmarkPriorityQueue(b *testing.B) {
+	for _, size := range []int{100, 10000, 1000000} {
+		pq := NewPriorityQueue(intLess)
+		for i := range size {
+			pq.Push((i * 7919) % size)
+		}
+
+		b.Run(fmt.Sprintf("PushPop_size_%d", size), func(b *testing.B) {
+			i := 0
+			for b.Loop() {
+				pq.Push(i % size)
+				_, _ = pq.Pop()
+				i++
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+// Queue is a generic first in, first out queue built on a DoublyLinkedList.
+// Elements are added at the back and removed from the front, so Enqueue,
+// Dequeue, and Peek are each O(1).
+type Queue[T comparable] struct {
+	list *DoublyLinkedList[T]
+}
+
+// NewQueue creates a new empty queue.
+func NewQueue[T comparable]() *Queue[T] {
+	return &Queue[T]{
+		list: NewDoublyLinkedList[T](),
+	}
+}
+
+// Enqueue adds an element to the back of the queue - O(1).
+func (q *Queue[T]) Enqueue(value T) {
+	q.list.PushBack(value)
+}
+
+// Dequeue removes and returns the front element - O(1). The bool is false if
+// the queue is empty.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	return q.list.PopFront()
+}
+
+// Peek returns the front element without removing it - O(1). The bool is
+// false if the queue is empty.
+func (q *Queue[T]) Peek() (T, bool) {
+	return q.list.Front()
+}
+
+// Len returns the number of elements in the queue - O(1).
+func (q *Queue[T]) Len() int {
+	return q.list.Len()
+}
+
+// IsEmpty returns true if the queue is empty - O(1).
+func (q *Queue[T]) IsEmpty() bool {
+	return q.list.IsEmpty()
+}
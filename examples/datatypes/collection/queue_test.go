@@ -0,0 +1,56 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"testing"
+)
+
+func TestQueue(t *testing.T) {
+	q := NewQueue[int]()
+	if !q.IsEmpty() || q.Len() != 0 {
+		t.Fatalf("NewQueue() should create empty queue, got len=%d, empty=%v", q.Len(), q.IsEmpty())
+	}
+
+	if got, ok := q.Dequeue(); ok || got != 0 {
+		t.Errorf("Dequeue() on empty queue = (%d, %v), want (0, false)", got, ok)
+	}
+
+	if got, ok := q.Peek(); ok || got != 0 {
+		t.Errorf("Peek() on empty queue = (%d, %v), want (0, false)", got, ok)
+	}
+
+	for i := 1; i <= 3; i++ {
+		q.Enqueue(i)
+	}
+
+	if q.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", q.Len())
+	}
+
+	if got, ok := q.Peek(); !ok || got != 1 {
+		t.Errorf("Peek() = (%d, %v), want (1, true)", got, ok)
+	}
+
+	for want := 1; want <= 3; want++ {
+		if got, ok := q.Dequeue(); !ok || got != want {
+			t.Errorf("Dequeue() = (%d, %v), want (%d, true)", got, ok, want)
+		}
+	}
+
+	if !q.IsEmpty() {
+		t.Errorf("IsEmpty() after dequeuing everything = false, want true")
+	}
+}
@@ -0,0 +1,312 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"cmp"
+	"iter"
+)
+
+// sortedMapNode is a node in the AVL tree backing a SortedMap.
+type sortedMapNode[K cmp.Ordered, V any] struct {
+	key    K
+	value  V
+	height int
+	left   *sortedMapNode[K, V]
+	right  *sortedMapNode[K, V]
+}
+
+// SortedMap is an ordered symbol table backed by an AVL tree. Unlike the
+// built-in map it keeps its keys in order, which gives ordered iteration,
+// Floor and Ceiling lookups, and range queries. The tree stays balanced, so
+// Put, Get, Delete, Floor, and Ceiling are all O(log n), and iterating over
+// a range of k entries is O(log n + k).
+type SortedMap[K cmp.Ordered, V any] struct {
+	root *sortedMapNode[K, V]
+	size int
+}
+
+// NewSortedMap creates an empty SortedMap.
+func NewSortedMap[K cmp.Ordered, V any]() *SortedMap[K, V] {
+	return &SortedMap[K, V]{
+		root: nil,
+		size: 0,
+	}
+}
+
+// Len returns the number of entries in the map - O(1).
+func (m *SortedMap[K, V]) Len() int {
+	return m.size
+}
+
+// Put stores value for key, replacing any existing value - O(log n).
+func (m *SortedMap[K, V]) Put(key K, value V) {
+	m.root = m.put(m.root, key, value)
+}
+
+// put inserts into the subtree rooted at node and returns its new root
+// after rebalancing.
+func (m *SortedMap[K, V]) put(node *sortedMapNode[K, V], key K, value V) *sortedMapNode[K, V] {
+	if node == nil {
+		m.size++
+
+		return &sortedMapNode[K, V]{
+			key:    key,
+			value:  value,
+			height: 1,
+			left:   nil,
+			right:  nil,
+		}
+	}
+
+	switch c := cmp.Compare(key, node.key); {
+	case c < 0:
+		node.left = m.put(node.left, key, value)
+	case c > 0:
+		node.right = m.put(node.right, key, value)
+	default:
+		node.value = value
+
+		return node
+	}
+
+	return rebalance(node)
+}
+
+// Get returns the value stored for key - O(log n). The bool is false if the
+// key is not in the map.
+func (m *SortedMap[K, V]) Get(key K) (V, bool) {
+	node := m.root
+	for node != nil {
+		switch c := cmp.Compare(key, node.key); {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			node = node.right
+		default:
+			return node.value, true
+		}
+	}
+
+	var zero V
+
+	return zero, false
+}
+
+// Delete removes key from the map - O(log n). It returns false if the key
+// was not present.
+func (m *SortedMap[K, V]) Delete(key K) bool {
+	size := m.size
+	m.root = m.delete(m.root, key)
+
+	return m.size < size
+}
+
+// delete removes key from the subtree rooted at node and returns its new
+// root after rebalancing.
+func (m *SortedMap[K, V]) delete(node *sortedMapNode[K, V], key K) *sortedMapNode[K, V] {
+	if node == nil {
+		return nil
+	}
+
+	switch c := cmp.Compare(key, node.key); {
+	case c < 0:
+		node.left = m.delete(node.left, key)
+	case c > 0:
+		node.right = m.delete(node.right, key)
+	default:
+		if node.left == nil {
+			m.size--
+
+			return node.right
+		}
+
+		if node.right == nil {
+			m.size--
+
+			return node.left
+		}
+
+		// Two children: replace this entry with its successor, then remove
+		// the successor from the right subtree.
+		successor := node.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+
+		node.key, node.value = successor.key, successor.value
+		node.right = m.delete(node.right, successor.key)
+	}
+
+	return rebalance(node)
+}
+
+// Floor returns the entry with the largest key less than or equal to key -
+// O(log n). The bool is false if every key is greater than key.
+func (m *SortedMap[K, V]) Floor(key K) (K, V, bool) {
+	var best *sortedMapNode[K, V]
+
+	node := m.root
+	for node != nil {
+		switch c := cmp.Compare(key, node.key); {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			// A candidate, but there may be a closer one to the right.
+			best = node
+			node = node.right
+		default:
+			return node.key, node.value, true
+		}
+	}
+
+	return entryOf(best)
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to
+// key - O(log n). The bool is false if every key is less than key.
+func (m *SortedMap[K, V]) Ceiling(key K) (K, V, bool) {
+	var best *sortedMapNode[K, V]
+
+	node := m.root
+	for node != nil {
+		switch c := cmp.Compare(key, node.key); {
+		case c < 0:
+			// A candidate, but there may be a closer one to the left.
+			best = node
+			node = node.left
+		case c > 0:
+			node = node.right
+		default:
+			return node.key, node.value, true
+		}
+	}
+
+	return entryOf(best)
+}
+
+// Range returns an iterator over the entries with keys in [lo, hi] in
+// ascending key order. Subtrees entirely outside the range are skipped, so
+// visiting k entries is O(log n + k).
+func (m *SortedMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.walk(m.root, &lo, &hi, yield)
+	}
+}
+
+// All returns an iterator over every entry in ascending key order - O(n).
+func (m *SortedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.walk(m.root, nil, nil, yield)
+	}
+}
+
+// walk visits the subtree rooted at node in order, limited to keys in
+// [lo, hi] where a nil bound is unlimited. It returns false once yield asks
+// to stop.
+func (m *SortedMap[K, V]) walk(node *sortedMapNode[K, V], lo, hi *K, yield func(K, V) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	aboveLo := lo == nil || cmp.Compare(*lo, node.key) <= 0
+	belowHi := hi == nil || cmp.Compare(node.key, *hi) <= 0
+
+	if aboveLo && !m.walk(node.left, lo, hi, yield) {
+		return false
+	}
+
+	if aboveLo && belowHi && !yield(node.key, node.value) {
+		return false
+	}
+
+	if belowHi {
+		return m.walk(node.right, lo, hi, yield)
+	}
+
+	return true
+}
+
+// entryOf returns the key and value of node, or zero values and false if
+// node is nil.
+func entryOf[K cmp.Ordered, V any](node *sortedMapNode[K, V]) (K, V, bool) {
+	if node == nil {
+		var zeroK K
+		var zeroV V
+
+		return zeroK, zeroV, false
+	}
+
+	return node.key, node.value, true
+}
+
+// nodeHeight returns the height of node, where an empty subtree is 0.
+func nodeHeight[K cmp.Ordered, V any](node *sortedMapNode[K, V]) int {
+	if node == nil {
+		return 0
+	}
+
+	return node.height
+}
+
+// rebalance updates the height of node and applies the AVL rotations needed
+// to keep its subtrees within one level of each other, returning the new
+// root of the subtree.
+func rebalance[K cmp.Ordered, V any](node *sortedMapNode[K, V]) *sortedMapNode[K, V] {
+	node.height = 1 + max(nodeHeight(node.left), nodeHeight(node.right))
+
+	switch balance := nodeHeight(node.left) - nodeHeight(node.right); {
+	case balance > 1:
+		// Left heavy. A right-leaning left child needs a double rotation.
+		if nodeHeight(node.left.left) < nodeHeight(node.left.right) {
+			node.left = rotateLeft(node.left)
+		}
+
+		return rotateRight(node)
+	case balance < -1:
+		// Right heavy. A left-leaning right child needs a double rotation.
+		if nodeHeight(node.right.right) < nodeHeight(node.right.left) {
+			node.right = rotateRight(node.right)
+		}
+
+		return rotateLeft(node)
+	}
+
+	return node
+}
+
+// rotateLeft makes the right child of node the new subtree root.
+func rotateLeft[K cmp.Ordered, V any](node *sortedMapNode[K, V]) *sortedMapNode[K, V] {
+	pivot := node.right
+	node.right = pivot.left
+	pivot.left = node
+
+	node.height = 1 + max(nodeHeight(node.left), nodeHeight(node.right))
+	pivot.height = 1 + max(nodeHeight(pivot.left), nodeHeight(pivot.right))
+
+	return pivot
+}
+
+// rotateRight makes the left child of node the new subtree root.
+func rotateRight[K cmp.Ordered, V any](node *sortedMapNode[K, V]) *sortedMapNode[K, V] {
+	pivot := node.left
+	node.left = pivot.right
+	pivot.right = node
+
+	node.height = 1 + max(nodeHeight(node.left), nodeHeight(node.right))
+	pivot.height = 1 + max(nodeHeight(pivot.left), nodeHeight(pivot.right))
+
+	return pivot
+}
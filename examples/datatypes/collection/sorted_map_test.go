@@ -0,0 +1,243 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// collectKeys gathers the keys produced by an iterator in order.
+func collectKeys[K any, V any](seq iter.Seq2[K, V]) []K {
+	keys := []K{}
+	for k := range seq {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+func TestSortedMapPutGetDelete(t *testing.T) {
+	m := NewSortedMap[string, int]()
+
+	if _, ok := m.Get("missing"); ok {
+		t.Errorf("Get(missing) on empty map should return false")
+	}
+
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("c", 3)
+	m.Put("b", 20)
+
+	if got := m.Len(); got != 3 {
+		t.Errorf("Len() = %d, want 3", got)
+	}
+
+	if got, ok := m.Get("b"); !ok || got != 20 {
+		t.Errorf("Get(b) = %d, %v, want 20, true", got, ok)
+	}
+
+	if !m.Delete("b") {
+		t.Errorf("Delete(b) = false, want true")
+	}
+
+	if m.Delete("b") {
+		t.Errorf("Delete(b) a second time = true, want false")
+	}
+
+	if _, ok := m.Get("b"); ok {
+		t.Errorf("Get(b) after Delete should return false")
+	}
+
+	if got, want := collectKeys(m.All()), []string{"a", "c"}; !cmp.Equal(got, want) {
+		t.Errorf("All() keys = %v, want %v", got, want)
+	}
+}
+
+func TestSortedMapFloorCeiling(t *testing.T) {
+	m := NewSortedMap[int, string]()
+	for _, k := range []int{10, 20, 30, 40} {
+		m.Put(k, fmt.Sprint(k))
+	}
+
+	tests := []struct {
+		key         int
+		wantFloor   int
+		floorOK     bool
+		wantCeiling int
+		ceilingOK   bool
+	}{
+		{key: 5, wantFloor: 0, floorOK: false, wantCeiling: 10, ceilingOK: true},
+		{key: 10, wantFloor: 10, floorOK: true, wantCeiling: 10, ceilingOK: true},
+		{key: 15, wantFloor: 10, floorOK: true, wantCeiling: 20, ceilingOK: true},
+		{key: 30, wantFloor: 30, floorOK: true, wantCeiling: 30, ceilingOK: true},
+		{key: 39, wantFloor: 30, floorOK: true, wantCeiling: 40, ceilingOK: true},
+		{key: 40, wantFloor: 40, floorOK: true, wantCeiling: 40, ceilingOK: true},
+		{key: 45, wantFloor: 40, floorOK: true, wantCeiling: 0, ceilingOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("key_%d", tt.key), func(t *testing.T) {
+			k, v, ok := m.Floor(tt.key)
+			if k != tt.wantFloor || ok != tt.floorOK {
+				t.Errorf("Floor(%d) = %d, %v, want %d, %v", tt.key, k, ok, tt.wantFloor, tt.floorOK)
+			}
+
+			if ok && v != fmt.Sprint(k) {
+				t.Errorf("Floor(%d) value = %q, want %q", tt.key, v, fmt.Sprint(k))
+			}
+
+			k, v, ok = m.Ceiling(tt.key)
+			if k != tt.wantCeiling || ok != tt.ceilingOK {
+				t.Errorf("Ceiling(%d) = %d, %v, want %d, %v", tt.key, k, ok, tt.wantCeiling, tt.ceilingOK)
+			}
+
+			if ok && v != fmt.Sprint(k) {
+				t.Errorf("Ceiling(%d) value = %q, want %q", tt.key, v, fmt.Sprint(k))
+			}
+		})
+	}
+
+	empty := NewSortedMap[int, string]()
+	if _, _, ok := empty.Floor(1); ok {
+		t.Errorf("Floor on empty map should return false")
+	}
+
+	if _, _, ok := empty.Ceiling(1); ok {
+		t.Errorf("Ceiling on empty map should return false")
+	}
+}
+
+func TestSortedMapRange(t *testing.T) {
+	m := NewSortedMap[int, int]()
+	for _, k := range []int{50, 10, 40, 20, 30} {
+		m.Put(k, k*10)
+	}
+
+	tests := []struct {
+		name   string
+		lo, hi int
+		want   []int
+	}{
+		{"whole map", 0, 100, []int{10, 20, 30, 40, 50}},
+		{"inclusive bounds", 20, 40, []int{20, 30, 40}},
+		{"bounds between keys", 15, 45, []int{20, 30, 40}},
+		{"single key", 30, 30, []int{30}},
+		{"below all keys", 0, 5, []int{}},
+		{"above all keys", 60, 70, []int{}},
+		{"inverted bounds", 40, 20, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := collectKeys(m.Range(tt.lo, tt.hi)); !cmp.Equal(got, tt.want) {
+				t.Errorf("Range(%d, %d) keys = %v, want %v", tt.lo, tt.hi, got, tt.want)
+			}
+		})
+	}
+
+	// Stopping early must be honored.
+	var got []int
+	for k, v := range m.Range(0, 100) {
+		if v != k*10 {
+			t.Errorf("Range value for key %d = %d, want %d", k, v, k*10)
+		}
+
+		got = append(got, k)
+		if len(got) == 2 {
+			break
+		}
+	}
+
+	if want := []int{10, 20}; !cmp.Equal(got, want) {
+		t.Errorf("Range with break = %v, want %v", got, want)
+	}
+}
+
+func TestSortedMapOrderedAndBalanced(t *testing.T) {
+	m := NewSortedMap[int, int]()
+	ref := make(map[int]int)
+
+	// Insert keys in a scrambled order, then delete every third one.
+	const size = 1000
+	for i := range size {
+		k := (i * 7919) % size
+		m.Put(k, i)
+		ref[k] = i
+	}
+
+	for k := 0; k < size; k += 3 {
+		m.Delete(k)
+		delete(ref, k)
+	}
+
+	if m.Len() != len(ref) {
+		t.Errorf("Len() = %d, want %d", m.Len(), len(ref))
+	}
+
+	if got, want := collectKeys(m.All()), slices.Sorted(maps.Keys(ref)); !cmp.Equal(got, want) {
+		t.Errorf("All() keys are not the sorted reference keys")
+	}
+
+	for k, want := range ref {
+		if got, ok := m.Get(k); !ok || got != want {
+			t.Errorf("Get(%d) = %d, %v, want %d, true", k, got, ok, want)
+		}
+	}
+
+	// An AVL tree's height is at most about 1.44 log2(n).
+	if h := nodeHeight(m.root); h > 15 {
+		t.Errorf("tree height = %d for %d entries, want a balanced height of at most 15", h, m.Len())
+	}
+}
+
+// BenchmarkSortedMapRange contrasts a range query on a SortedMap with the
+// sort-on-demand approach a plain map needs: collect, sort, then filter.
+func BenchmarkSortedMapRange(b *testing.B) {
+	for _, size := range []int{1000, 100000} {
+		sm := NewSortedMap[int, int]()
+		plain := make(map[int]int, size)
+		for i := range size {
+			k := (i * 7919) % size
+			sm.Put(k, i)
+			plain[k] = i
+		}
+
+		lo, hi := size/2, size/2+100
+
+		b.Run(fmt.Sprintf("SortedMap_size_%d", size), func(b *testing.B) {
+			for b.Loop() {
+				for k, v := range sm.Range(lo, hi) {
+					_, _ = k, v
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("PlainMap_size_%d", size), func(b *testing.B) {
+			for b.Loop() {
+				for _, k := range slices.Sorted(maps.Keys(plain)) {
+					if k >= lo && k <= hi {
+						_ = plain[k]
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+// Stack is a generic last in, first out stack built on a DoublyLinkedList.
+// Push, Pop, and Peek all work at the back of the list, so each is O(1).
+type Stack[T comparable] struct {
+	list *DoublyLinkedList[T]
+}
+
+// NewStack creates a new empty stack.
+func NewStack[T comparable]() *Stack[T] {
+	return &Stack[T]{
+		list: NewDoublyLinkedList[T](),
+	}
+}
+
+// Push adds an element to the top of the stack - O(1).
+func (s *Stack[T]) Push(value T) {
+	s.list.PushBack(value)
+}
+
+// Pop removes and returns the top element - O(1). The bool is false if the
+// stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	return s.list.PopBack()
+}
+
+// Peek returns the top element without removing it - O(1). The bool is false
+// if the stack is empty.
+func (s *Stack[T]) Peek() (T, bool) {
+	return s.list.Back()
+}
+
+// Len returns the number of elements in the stack - O(1).
+func (s *Stack[T]) Len() int {
+	return s.list.Len()
+}
+
+// IsEmpty returns true if the stack is empty - O(1).
+func (s *Stack[T]) IsEmpty() bool {
+	return s.list.IsEmpty()
+}
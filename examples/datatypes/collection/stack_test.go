@@ -0,0 +1,56 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	s := NewStack[string]()
+	if !s.IsEmpty() || s.Len() != 0 {
+		t.Fatalf("NewStack() should create empty stack, got len=%d, empty=%v", s.Len(), s.IsEmpty())
+	}
+
+	if got, ok := s.Pop(); ok || got != "" {
+		t.Errorf("Pop() on empty stack = (%q, %v), want (\"\", false)", got, ok)
+	}
+
+	if got, ok := s.Peek(); ok || got != "" {
+		t.Errorf("Peek() on empty stack = (%q, %v), want (\"\", false)", got, ok)
+	}
+
+	for _, v := range []string{"a", "b", "c"} {
+		s.Push(v)
+	}
+
+	if s.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", s.Len())
+	}
+
+	if got, ok := s.Peek(); !ok || got != "c" {
+		t.Errorf("Peek() = (%q, %v), want (\"c\", true)", got, ok)
+	}
+
+	for _, want := range []string{"c", "b", "a"} {
+		if got, ok := s.Pop(); !ok || got != want {
+			t.Errorf("Pop() = (%q, %v), want (%q, true)", got, ok, want)
+		}
+	}
+
+	if !s.IsEmpty() {
+		t.Errorf("IsEmpty() after popping everything = false, want true")
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SyncDoublyLinkedList is a DoublyLinkedList that is safe for concurrent use,
+// e.g., as a work queue shared by several goroutines. Methods that modify the
+// list hold a write lock and methods that only read it hold a read lock, so
+// readers don't block each other. Every operation keeps the complexity of the
+// matching DoublyLinkedList method.
+type SyncDoublyLinkedList[T comparable] struct {
+	mu   sync.RWMutex
+	list *DoublyLinkedList[T]
+}
+
+// NewSyncDoublyLinkedList creates a new empty concurrent-safe doubly linked list.
+func NewSyncDoublyLinkedList[T comparable]() *SyncDoublyLinkedList[T] {
+	return &SyncDoublyLinkedList[T]{
+		mu:   sync.RWMutex{},
+		list: NewDoublyLinkedList[T](),
+	}
+}
+
+// PushFront adds an element to the beginning of the list - O(1).
+func (s *SyncDoublyLinkedList[T]) PushFront(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.list.PushFront(value)
+}
+
+// PushBack adds an element to the end of the list - O(1).
+func (s *SyncDoublyLinkedList[T]) PushBack(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.list.PushBack(value)
+}
+
+// PopFront removes and returns the first element - O(1).
+func (s *SyncDoublyLinkedList[T]) PopFront() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.PopFront()
+}
+
+// PopBack removes and returns the last element - O(1).
+func (s *SyncDoublyLinkedList[T]) PopBack() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.PopBack()
+}
+
+// Insert adds an element at the specified index - O(min(index, size-index)).
+func (s *SyncDoublyLinkedList[T]) Insert(index int, value T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.Insert(index, value)
+}
+
+// Remove removes the element at the specified index - O(min(index, size-index)).
+func (s *SyncDoublyLinkedList[T]) Remove(index int) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.Remove(index)
+}
+
+// RemoveValue removes the first occurrence of the value, returning whether
+// one was found - O(n).
+func (s *SyncDoublyLinkedList[T]) RemoveValue(value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.RemoveValue(value)
+}
+
+// Reverse reverses the order of the list in place - O(n).
+func (s *SyncDoublyLinkedList[T]) Reverse() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.list.Reverse()
+}
+
+// Clear removes all elements from the list - O(1).
+func (s *SyncDoublyLinkedList[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.list.Clear()
+}
+
+// Front returns the first element without removing it - O(1).
+func (s *SyncDoublyLinkedList[T]) Front() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.Front()
+}
+
+// Back returns the last element without removing it - O(1).
+func (s *SyncDoublyLinkedList[T]) Back() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.Back()
+}
+
+// At returns the element at the specified index - O(min(index, size-index)).
+func (s *SyncDoublyLinkedList[T]) At(index int) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.At(index)
+}
+
+// Find returns the index of the first occurrence of the value, or -1 if not found - O(n).
+func (s *SyncDoublyLinkedList[T]) Find(value T) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.Find(value)
+}
+
+// Contains checks if the list contains the specified value - O(n).
+func (s *SyncDoublyLinkedList[T]) Contains(value T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.Contains(value)
+}
+
+// Len returns the number of elements in the list - O(1).
+func (s *SyncDoublyLinkedList[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.Len()
+}
+
+// IsEmpty returns true if the list is empty - O(1).
+func (s *SyncDoublyLinkedList[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.IsEmpty()
+}
+
+// ToSlice converts the list to a slice - O(n).
+func (s *SyncDoublyLinkedList[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.ToSlice()
+}
+
+// ToSliceReverse converts the list to a slice in reverse order - O(n).
+func (s *SyncDoublyLinkedList[T]) ToSliceReverse() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.ToSliceReverse()
+}
+
+// String returns a string representation of the list.
+func (s *SyncDoublyLinkedList[T]) String() string {
+	return fmt.Sprintf("SyncDoublyLinkedList%v", s.ToSlice())
+}
+
+// Iterator returns a forward iterator over a snapshot of the list - O(n).
+// The snapshot is taken under the read lock, so the lock is not held while
+// iterating and later changes to the list are not seen by the iterator.
+func (s *SyncDoublyLinkedList[T]) Iterator() Iterator[T] {
+	return &sliceIterator[T]{values: s.ToSlice()}
+}
+
+// ReverseIterator returns a reverse iterator over a snapshot of the list -
+// O(n). As with Iterator, the lock is not held while iterating.
+func (s *SyncDoublyLinkedList[T]) ReverseIterator() ReverseIterator[T] {
+	return &sliceReverseIterator[T]{values: s.ToSliceReverse()}
+}
+
+// sliceIterator implements Iterator over a slice.
+type sliceIterator[T comparable] struct {
+	values []T
+}
+
+// HasNext returns true if there are more elements to iterate over.
+func (it *sliceIterator[T]) HasNext() bool {
+	return len(it.values) > 0
+}
+
+// Next returns the next element and advances the iterator.
+func (it *sliceIterator[T]) Next() (T, bool) {
+	if len(it.values) == 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	value := it.values[0]
+	it.values = it.values[1:]
+
+	return value, true
+}
+
+// Value returns the current element without advancing the iterator.
+func (it *sliceIterator[T]) Value() T {
+	if len(it.values) == 0 {
+		var zero T
+
+		return zero
+	}
+
+	return it.values[0]
+}
+
+// sliceReverseIterator implements ReverseIterator over a slice that is
+// already in reverse order.
+type sliceReverseIterator[T comparable] struct {
+	values []T
+}
+
+// HasPrev returns true if there are more elements to iterate over in reverse.
+func (it *sliceReverseIterator[T]) HasPrev() bool {
+	return len(it.values) > 0
+}
+
+// Prev returns the previous element and moves the iterator backward.
+func (it *sliceReverseIterator[T]) Prev() (T, bool) {
+	if len(it.values) == 0 {
+		var zero T
+
+		return zero, false
+	}
+
+	value := it.values[0]
+	it.values = it.values[1:]
+
+	return value, true
+}
+
+// Value returns the current element without moving the iterator.
+func (it *sliceReverseIterator[T]) Value() T {
+	if len(it.values) == 0 {
+		var zero T
+
+		return zero
+	}
+
+	return it.values[0]
+}
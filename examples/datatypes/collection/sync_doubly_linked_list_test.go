@@ -0,0 +1,181 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collection
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSyncDoublyLinkedList(t *testing.T) {
+	s := NewSyncDoublyLinkedList[int]()
+	if !s.IsEmpty() || s.Len() != 0 {
+		t.Fatalf("NewSyncDoublyLinkedList() should create empty list, got len=%d, empty=%v", s.Len(), s.IsEmpty())
+	}
+
+	s.PushBack(2)
+	s.PushBack(3)
+	s.PushFront(1)
+	if err := s.Insert(3, 4); err != nil {
+		t.Fatalf("Insert(3, 4) returned error: %v", err)
+	}
+
+	if got := s.ToSlice(); !cmp.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("ToSlice() = %v, want [1 2 3 4]", got)
+	}
+
+	if got := s.ToSliceReverse(); !cmp.Equal(got, []int{4, 3, 2, 1}) {
+		t.Errorf("ToSliceReverse() = %v, want [4 3 2 1]", got)
+	}
+
+	if got, ok := s.At(2); !ok || got != 3 {
+		t.Errorf("At(2) = (%d, %v), want (3, true)", got, ok)
+	}
+
+	if got := s.Find(4); got != 3 {
+		t.Errorf("Find(4) = %d, want 3", got)
+	}
+
+	if !s.Contains(2) || s.Contains(9) {
+		t.Errorf("Contains(2), Contains(9) = %v, %v, want true, false", s.Contains(2), s.Contains(9))
+	}
+
+	if !s.RemoveValue(2) {
+		t.Errorf("RemoveValue(2) = false, want true")
+	}
+
+	if got, err := s.Remove(0); err != nil || got != 1 {
+		t.Errorf("Remove(0) = (%d, %v), want (1, nil)", got, err)
+	}
+
+	s.Reverse()
+	if got := s.String(); got != "SyncDoublyLinkedList[4 3]" {
+		t.Errorf("String() = %q, want %q", got, "SyncDoublyLinkedList[4 3]")
+	}
+
+	if got, ok := s.Front(); !ok || got != 4 {
+		t.Errorf("Front() = (%d, %v), want (4, true)", got, ok)
+	}
+
+	if got, ok := s.Back(); !ok || got != 3 {
+		t.Errorf("Back() = (%d, %v), want (3, true)", got, ok)
+	}
+
+	if got, ok := s.PopBack(); !ok || got != 3 {
+		t.Errorf("PopBack() = (%d, %v), want (3, true)", got, ok)
+	}
+
+	s.Clear()
+	if got, ok := s.PopFront(); ok {
+		t.Errorf("PopFront() after Clear() = (%d, %v), want (0, false)", got, ok)
+	}
+}
+
+func TestSyncDoublyLinkedListIteratorSnapshot(t *testing.T) {
+	s := NewSyncDoublyLinkedList[int]()
+	for i := 1; i <= 3; i++ {
+		s.PushBack(i)
+	}
+
+	it := s.Iterator()
+	rit := s.ReverseIterator()
+
+	// Changes after the snapshot are not seen by the iterators.
+	s.PushBack(4)
+	s.PopFront()
+
+	var got []int
+	for it.HasNext() {
+		v, _ := it.Next()
+		got = append(got, v)
+	}
+
+	if !cmp.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Iterator() = %v, want [1 2 3]", got)
+	}
+
+	got = nil
+	for rit.HasPrev() {
+		v, _ := rit.Prev()
+		got = append(got, v)
+	}
+
+	if !cmp.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("ReverseIterator() = %v, want [3 2 1]", got)
+	}
+
+	if _, ok := it.Next(); ok {
+		t.Errorf("Next() on an exhausted iterator returned true")
+	}
+}
+
+// TestSyncDoublyLinkedListConcurrent hammers the list from several goroutines
+// at once. Run with -race to check the locking.
+func TestSyncDoublyLinkedListConcurrent(t *testing.T) {
+	const (
+		producers = 8
+		consumers = 8
+		perWorker = 1000
+	)
+
+	s := NewSyncDoublyLinkedList[int]()
+
+	var wg sync.WaitGroup
+	for p := range producers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				s.PushBack(p*perWorker + i)
+				_ = s.Len()
+			}
+		}()
+	}
+
+	popped := make([][]int, consumers)
+	for c := range consumers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range perWorker / 2 {
+				if v, ok := s.PopFront(); ok {
+					popped[c] = append(popped[c], v)
+				}
+				_ = s.ToSlice()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Every value pushed is either still in the list or was popped exactly once.
+	got := s.ToSlice()
+	for _, p := range popped {
+		got = append(got, p...)
+	}
+	slices.Sort(got)
+
+	want := make([]int, producers*perWorker)
+	for i := range want {
+		want[i] = i
+	}
+
+	if !cmp.Equal(got, want) {
+		t.Errorf("After concurrent PushBack/PopFront: got %d values, want each of 0..%d exactly once", len(got), len(want)-1)
+	}
+}
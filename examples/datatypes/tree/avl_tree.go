@@ -0,0 +1,169 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// AVLNode is a node of a self-balancing binary search tree. After every
+// Insert or Delete the heights of the two subtrees of each node differ by at
+// most 1, which keeps the height below about 1.44·log₂(n). Unlike BSTNode,
+// whose height grows to n when sorted values are inserted one at a time,
+// search, insertion, and deletion stay O(log n) for any insertion order.
+type AVLNode struct {
+	Val   int
+	Left  *AVLNode
+	Right *AVLNode
+
+	height int // Height of the subtree rooted here, 1 for a leaf.
+}
+
+// NewAVLNode creates a new AVLNode with the given value.
+func NewAVLNode(val int) *AVLNode {
+	return &AVLNode{Val: val, Left: nil, Right: nil, height: 1}
+}
+
+// Height returns the height of the tree - O(1), since each node tracks the
+// height of its subtree. The height of a nil tree is 0.
+func (an *AVLNode) Height() int {
+	if an == nil {
+		return 0
+	}
+
+	return an.height
+}
+
+// Insert inserts a value into the tree and returns the new root, which may
+// differ from an after rebalancing - O(log n). Duplicate values are ignored.
+func (an *AVLNode) Insert(val int) *AVLNode {
+	if an == nil {
+		return NewAVLNode(val)
+	}
+
+	switch {
+	case val < an.Val:
+		an.Left = an.Left.Insert(val)
+	case val > an.Val:
+		an.Right = an.Right.Insert(val)
+	default:
+		return an
+	}
+
+	return an.rebalance()
+}
+
+// Delete removes a value from the tree and returns the new root, which may
+// differ from an after rebalancing - O(log n). Deleting a value that is not
+// in the tree returns it unchanged. A node with two children takes the value
+// of its in-order successor, which is then deleted from the right subtree.
+func (an *AVLNode) Delete(val int) *AVLNode {
+	if an == nil {
+		return nil
+	}
+
+	switch {
+	case val < an.Val:
+		an.Left = an.Left.Delete(val)
+	case val > an.Val:
+		an.Right = an.Right.Delete(val)
+	case an.Left == nil:
+		return an.Right
+	case an.Right == nil:
+		return an.Left
+	default:
+		successor := an.Right
+		for successor.Left != nil {
+			successor = successor.Left
+		}
+
+		an.Val = successor.Val
+		an.Right = an.Right.Delete(successor.Val)
+	}
+
+	return an.rebalance()
+}
+
+// InorderTraversal returns values in inorder traversal, which is sorted order.
+func (an *AVLNode) InorderTraversal() []int {
+	if an == nil {
+		return nil
+	}
+
+	var result []int
+	result = append(result, an.Left.InorderTraversal()...)
+	result = append(result, an.Val)
+	result = append(result, an.Right.InorderTraversal()...)
+
+	return result
+}
+
+// updateHeight recomputes the height of an from its children.
+func (an *AVLNode) updateHeight() {
+	an.height = 1 + max(an.Left.Height(), an.Right.Height())
+}
+
+// balanceFactor returns the height of the left subtree minus the right.
+func (an *AVLNode) balanceFactor() int {
+	return an.Left.Height() - an.Right.Height()
+}
+
+// rebalance updates the height of an and, if its subtrees now differ in
+// height by 2, applies the rotation for whichever of the four cases it is in,
+// returning the new root of the subtree.
+func (an *AVLNode) rebalance() *AVLNode {
+	an.updateHeight()
+
+	switch balance := an.balanceFactor(); {
+	case balance > 1 && an.Left.balanceFactor() >= 0:
+		// LL: the left child's left subtree is too tall.
+		return an.rotateRight()
+	case balance > 1:
+		// LR: the left child's right subtree is too tall.
+		an.Left = an.Left.rotateLeft()
+
+		return an.rotateRight()
+	case balance < -1 && an.Right.balanceFactor() <= 0:
+		// RR: the right child's right subtree is too tall.
+		return an.rotateLeft()
+	case balance < -1:
+		// RL: the right child's left subtree is too tall.
+		an.Right = an.Right.rotateRight()
+
+		return an.rotateLeft()
+	}
+
+	return an
+}
+
+// rotateLeft makes the right child of an the new subtree root.
+func (an *AVLNode) rotateLeft() *AVLNode {
+	pivot := an.Right
+	an.Right = pivot.Left
+	pivot.Left = an
+
+	an.updateHeight()
+	pivot.updateHeight()
+
+	return pivot
+}
+
+// rotateRight makes the left child of an the new subtree root.
+func (an *AVLNode) rotateRight() *AVLNode {
+	pivot := an.Left
+	an.Left = pivot.Right
+	pivot.Right = an
+
+	an.updateHeight()
+	pivot.updateHeight()
+
+	return pivot
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// checkAVL returns an error if any node's stored height is wrong, its
+// subtrees differ in height by more than 1, or the values are out of order.
+func checkAVL(an *AVLNode) error {
+	if !slices.IsSorted(an.InorderTraversal()) {
+		return fmt.Errorf("values are not sorted: %v", an.InorderTraversal())
+	}
+
+	var check func(n *AVLNode) (int, error)
+	check = func(n *AVLNode) (int, error) {
+		if n == nil {
+			return 0, nil
+		}
+
+		left, err := check(n.Left)
+		if err != nil {
+			return 0, err
+		}
+
+		right, err := check(n.Right)
+		if err != nil {
+			return 0, err
+		}
+
+		if h := max(left, right) + 1; n.height != h {
+			return 0, fmt.Errorf("node %d has height %d, want %d", n.Val, n.height, h)
+		}
+
+		if left-right > 1 || right-left > 1 {
+			return 0, fmt.Errorf("node %d subtree heights %d and %d differ by more than 1", n.Val, left, right)
+		}
+
+		return n.height, nil
+	}
+
+	_, err := check(an)
+
+	return err
+}
+
+func TestAVLNodeRotations(t *testing.T) {
+	tests := []struct {
+		name    string
+		inserts []int
+		root    int
+	}{
+		{"LL", []int{3, 2, 1}, 2},
+		{"RR", []int{1, 2, 3}, 2},
+		{"LR", []int{3, 1, 2}, 2},
+		{"RL", []int{1, 3, 2}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var root *AVLNode
+			for _, v := range tt.inserts {
+				root = root.Insert(v)
+			}
+
+			if err := checkAVL(root); err != nil {
+				t.Errorf("Insert(%v): %v", tt.inserts, err)
+			}
+
+			if root.Val != tt.root || root.Height() != 2 {
+				t.Errorf("Insert(%v) root = %d with height %d, want %d with height 2", tt.inserts, root.Val, root.Height(), tt.root)
+			}
+		})
+	}
+}
+
+func TestAVLNodeSortedInserts(t *testing.T) {
+	for _, n := range []int{1, 10, 100, 1000} {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			var root *AVLNode
+			for i := 1; i <= n; i++ {
+				root = root.Insert(i)
+			}
+
+			if err := checkAVL(root); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := len(root.InorderTraversal()); got != n {
+				t.Errorf("InorderTraversal() has %d values, want %d", got, n)
+			}
+
+			// An AVL tree of n nodes is at most about 1.44·log₂(n+2) tall,
+			// and no binary tree is shorter than ⌈log₂(n+1)⌉.
+			lower := int(math.Ceil(math.Log2(float64(n + 1))))
+			upper := int(1.4405 * math.Log2(float64(n+2)))
+			if h := root.Height(); h < lower || h > upper {
+				t.Errorf("Height() after inserting 1..%d = %d, want between %d and %d", n, h, lower, upper)
+			}
+		})
+	}
+}
+
+func TestAVLNodeDelete(t *testing.T) {
+	const n = 500
+
+	r := rand.New(rand.NewSource(1))
+
+	var root *AVLNode
+	for _, v := range r.Perm(n) {
+		root = root.Insert(v)
+	}
+
+	// Inserting a duplicate changes nothing.
+	root = root.Insert(0)
+	if got := len(root.InorderTraversal()); got != n {
+		t.Fatalf("Insert() of a duplicate: %d values, want %d", got, n)
+	}
+
+	// Deleting an absent value changes nothing.
+	root = root.Delete(n + 1)
+	if got := len(root.InorderTraversal()); got != n {
+		t.Fatalf("Delete() of an absent value: %d values, want %d", got, n)
+	}
+
+	want := root.InorderTraversal()
+	for i, v := range r.Perm(n) {
+		root = root.Delete(v)
+		want = slices.DeleteFunc(want, func(w int) bool { return w == v })
+
+		if err := checkAVL(root); err != nil {
+			t.Fatalf("After Delete(%d): %v", v, err)
+		}
+
+		if got := root.InorderTraversal(); !slices.Equal(got, want) {
+			t.Fatalf("After %d deletes, InorderTraversal() = %v, want %v", i+1, got, want)
+		}
+	}
+
+	if root != nil {
+		t.Errorf("Delete() of every value left root = %v, want nil", root)
+	}
+}
@@ -31,9 +31,44 @@
 //	// Insert new values
 //	root = root.InsertBST(8)
 //
+//	// Delete values
+//	root = root.DeleteBST(4)
+//
 //	// Get sorted values via inorder traversal
 //	sorted := root.InorderTraversal()
 //
+//	// Inspect the shape: BuildBST is balanced, sorted inserts are not.
+//	height := root.Height()
+//	balanced := root.IsBalanced()
+//
+// # AVL Tree
+//
+// AVLNode is a self-balancing binary search tree. Insert and Delete apply
+// the LL, RR, LR, and RL rotations as needed so the subtree heights of every
+// node differ by at most 1, keeping all operations O(log n) worst case, even
+// for sorted input that degrades a plain BST to a linked list.
+//
+//	var avl *AVLNode
+//	for i := 1; i <= 1000; i++ {
+//		avl = avl.Insert(i)
+//	}
+//	avl = avl.Delete(500)
+//
+//	// Height stays near log₂(n) regardless of insertion order.
+//	height := avl.Height()
+//	sorted := avl.InorderTraversal()
+//
+// # Fenwick Tree
+//
+// FenwickTree (Binary Indexed Tree) keeps running sums over a slice while
+// letting elements change. Construction is O(n); Update, PrefixSum, and
+// RangeSum are each O(log n).
+//
+//	ft := NewFenwickTree([]int{3, 2, -1, 6, 5})
+//	ft.Update(2, 4)           // element 2 is now 3
+//	sum := ft.PrefixSum(2)    // 3 + 2 + 3 = 8
+//	part := ft.RangeSum(1, 3) // 2 + 3 + 6 = 11
+//
 // # Use Cases
 //
 // These data structures are commonly used in:
@@ -0,0 +1,84 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// FenwickTree (also called a Binary Indexed Tree) maintains prefix sums over
+// a slice of integers while allowing individual elements to change. Both
+// Update and PrefixSum are O(log n), compared to a plain slice where one of
+// the two is always O(n).
+//
+// Indexes are 0-based to match the input slice. Internally the tree is stored
+// 1-based, where entry i holds the sum of the i&-i elements ending at i.
+type FenwickTree struct {
+	tree []int
+}
+
+// NewFenwickTree creates a FenwickTree holding the values in vals - O(n).
+// Rather than performing n updates, each entry adds its partial sum into the
+// one entry that covers it, which is the standard in-place build.
+func NewFenwickTree(vals []int) *FenwickTree {
+	tree := make([]int, len(vals)+1)
+	copy(tree[1:], vals)
+
+	for i := 1; i < len(tree); i++ {
+		if parent := i + (i & -i); parent < len(tree) {
+			tree[parent] += tree[i]
+		}
+	}
+
+	return &FenwickTree{tree: tree}
+}
+
+// Len returns the number of elements in the tree.
+func (ft *FenwickTree) Len() int {
+	return len(ft.tree) - 1
+}
+
+// Update adds delta to the element at index i - O(log n).
+// It panics if i is out of range.
+func (ft *FenwickTree) Update(i, delta int) {
+	if i < 0 || i >= ft.Len() {
+		panic("tree: FenwickTree index out of range")
+	}
+
+	for j := i + 1; j < len(ft.tree); j += j & -j {
+		ft.tree[j] += delta
+	}
+}
+
+// PrefixSum returns the sum of the elements at indexes 0 through i
+// inclusive - O(log n). A negative i returns 0. It panics if i >= Len().
+func (ft *FenwickTree) PrefixSum(i int) int {
+	if i >= ft.Len() {
+		panic("tree: FenwickTree index out of range")
+	}
+
+	sum := 0
+	for j := i + 1; j > 0; j -= j & -j {
+		sum += ft.tree[j]
+	}
+
+	return sum
+}
+
+// RangeSum returns the sum of the elements at indexes l through r
+// inclusive - O(log n). It returns 0 if l > r.
+func (ft *FenwickTree) RangeSum(l, r int) int {
+	if l > r {
+		return 0
+	}
+
+	return ft.PrefixSum(r) - ft.PrefixSum(l-1)
+}
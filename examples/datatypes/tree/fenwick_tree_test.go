@@ -0,0 +1,115 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestFenwickTreeSums(t *testing.T) {
+	vals := []int{3, 2, -1, 6, 5, 4, -3, 3, 7, 2, 3}
+	ft := NewFenwickTree(vals)
+
+	if got := ft.Len(); got != len(vals) {
+		t.Errorf("Len() = %d, want %d", got, len(vals))
+	}
+
+	for l := range vals {
+		for r := l; r < len(vals); r++ {
+			want := 0
+			for _, v := range vals[l : r+1] {
+				want += v
+			}
+
+			if got := ft.RangeSum(l, r); got != want {
+				t.Errorf("RangeSum(%d, %d) = %d, want %d", l, r, got, want)
+			}
+		}
+	}
+
+	if got := ft.PrefixSum(-1); got != 0 {
+		t.Errorf("PrefixSum(-1) = %d, want 0", got)
+	}
+
+	if got := ft.RangeSum(5, 4); got != 0 {
+		t.Errorf("RangeSum(5, 4) = %d, want 0", got)
+	}
+}
+
+func TestFenwickTreeUpdate(t *testing.T) {
+	const n = 200
+
+	r := rand.New(rand.NewSource(1))
+
+	vals := make([]int, n)
+	for i := range vals {
+		vals[i] = r.Intn(100) - 50
+	}
+
+	ft := NewFenwickTree(vals)
+
+	for range 1000 {
+		i, delta := r.Intn(n), r.Intn(100)-50
+		ft.Update(i, delta)
+		vals[i] += delta
+
+		j := r.Intn(n)
+		want := 0
+		for _, v := range vals[:j+1] {
+			want += v
+		}
+
+		if got := ft.PrefixSum(j); got != want {
+			t.Fatalf("after Update(%d, %d), PrefixSum(%d) = %d, want %d", i, delta, j, got, want)
+		}
+	}
+}
+
+func TestFenwickTreeEmpty(t *testing.T) {
+	ft := NewFenwickTree(nil)
+
+	if got := ft.Len(); got != 0 {
+		t.Errorf("Len() = %d, want 0", got)
+	}
+
+	if got := ft.RangeSum(0, -1); got != 0 {
+		t.Errorf("RangeSum(0, -1) = %d, want 0", got)
+	}
+}
+
+func TestFenwickTreeOutOfRange(t *testing.T) {
+	ft := NewFenwickTree([]int{1, 2, 3})
+
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"Update past end", func() { ft.Update(3, 1) }},
+		{"Update negative", func() { ft.Update(-1, 1) }},
+		{"PrefixSum past end", func() { ft.PrefixSum(3) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", tt.name)
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
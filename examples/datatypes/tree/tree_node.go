@@ -63,6 +63,90 @@ func (tn *BSTNode) InsertBST(val int) *BSTNode {
 	return tn
 }
 
+// DeleteBST removes a value from a BST and returns the new root of the
+// subtree, which differs from tn when tn itself is deleted. Like InsertBST it
+// is O(h) for a tree of height h: O(log n) when balanced, O(n) when not.
+// Deleting a value that is not in the tree returns it unchanged.
+//
+// There are three cases for the node holding the value:
+//   - A leaf is simply removed.
+//   - A node with a single child is replaced by that child.
+//   - A node with two children takes the value of its in-order successor,
+//     the smallest value in its right subtree, which is then deleted from
+//     the right subtree. The successor has no left child, so that second
+//     delete is one of the first two cases.
+func (tn *BSTNode) DeleteBST(val int) *BSTNode {
+	if tn == nil {
+		return nil
+	}
+
+	switch {
+	case val < tn.Val:
+		tn.Left = tn.Left.DeleteBST(val)
+	case val > tn.Val:
+		tn.Right = tn.Right.DeleteBST(val)
+	case tn.Left == nil:
+		return tn.Right
+	case tn.Right == nil:
+		return tn.Left
+	default:
+		successor := tn.Right
+		for successor.Left != nil {
+			successor = successor.Left
+		}
+
+		tn.Val = successor.Val
+		tn.Right = tn.Right.DeleteBST(successor.Val)
+	}
+
+	return tn
+}
+
+// Height returns the number of nodes on the longest path from tn down to a
+// leaf - O(n), since every node must be visited. The height of a nil tree is
+// 0. A balanced tree has height about log n, while inserting sorted values
+// one at a time degenerates it into a list of height n, which is what makes
+// the search, insert, and delete operations O(n) in the worst case.
+func (tn *BSTNode) Height() int {
+	if tn == nil {
+		return 0
+	}
+
+	return max(tn.Left.Height(), tn.Right.Height()) + 1
+}
+
+// IsBalanced reports whether the heights of the left and right subtrees of
+// every node differ by at most 1 - O(n). A nil tree is balanced.
+func (tn *BSTNode) IsBalanced() bool {
+	_, balanced := tn.balancedHeight()
+
+	return balanced
+}
+
+// balancedHeight returns the height of the tree and whether it is balanced,
+// computing both in a single pass so each node is only visited once.
+func (tn *BSTNode) balancedHeight() (int, bool) {
+	if tn == nil {
+		return 0, true
+	}
+
+	leftHeight, leftBalanced := tn.Left.balancedHeight()
+	if !leftBalanced {
+		return 0, false
+	}
+
+	rightHeight, rightBalanced := tn.Right.balancedHeight()
+	if !rightBalanced {
+		return 0, false
+	}
+
+	if leftHeight-rightHeight > 1 || rightHeight-leftHeight > 1 {
+		return 0, false
+	}
+
+	return max(leftHeight, rightHeight) + 1, true
+}
+
 // InorderTraversal returns values in inorder traversal
 func (tn *BSTNode) InorderTraversal() []int {
 	if tn == nil {
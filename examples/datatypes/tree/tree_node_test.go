@@ -0,0 +1,128 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDeleteBST(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5, 6, 7}
+
+	tests := []struct {
+		name   string
+		root   *BSTNode
+		delete int
+		want   []int
+	}{
+		{"nil tree", nil, 1, nil},
+		{"only node", NewBSTNode(1), 1, nil},
+		{"leaf", BuildBST(values), 1, []int{2, 3, 4, 5, 6, 7}},
+		{"single child", NewBSTNode(1).InsertBST(2).InsertBST(3), 2, []int{1, 3}},
+		{"two children", BuildBST(values), 6, []int{1, 2, 3, 4, 5, 7}},
+		{"root with two children", BuildBST(values), 4, []int{1, 2, 3, 5, 6, 7}},
+		{"root with one child", NewBSTNode(1).InsertBST(2), 1, []int{2}},
+		{"absent value", BuildBST(values), 8, values},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(tt.root.InorderTraversal())
+
+			got := tt.root.DeleteBST(tt.delete).InorderTraversal()
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("DeleteBST(%d) = %v, want %v", tt.delete, got, tt.want)
+			}
+
+			wantLen := before
+			if slices.Contains(values, tt.delete) && before > 0 {
+				wantLen--
+			}
+
+			if len(got) != wantLen {
+				t.Errorf("DeleteBST(%d) left %d values, want %d", tt.delete, len(got), wantLen)
+			}
+		})
+	}
+}
+
+func TestDeleteBSTEveryValue(t *testing.T) {
+	// Insert in an order that produces nodes with zero, one, and two children.
+	inserts := []int{50, 30, 70, 20, 40, 60, 80, 35, 45, 65, 10, 85}
+
+	for _, del := range inserts {
+		var root *BSTNode
+		for _, v := range inserts {
+			root = root.InsertBST(v)
+		}
+
+		root = root.DeleteBST(del)
+		got := root.InorderTraversal()
+
+		if !slices.IsSorted(got) {
+			t.Errorf("DeleteBST(%d) left an unsorted tree: %v", del, got)
+		}
+
+		if len(got) != len(inserts)-1 || slices.Contains(got, del) {
+			t.Errorf("DeleteBST(%d) = %v, want every value except %d", del, got, del)
+		}
+	}
+}
+
+func TestHeightAndIsBalanced(t *testing.T) {
+	const size = 63
+
+	values := make([]int, size)
+	for i := range size {
+		values[i] = i + 1
+	}
+
+	// Sequential InsertBST of sorted values degenerates into a linked list.
+	var degenerate *BSTNode
+	for _, v := range values {
+		degenerate = degenerate.InsertBST(v)
+	}
+
+	// Balanced at the root, but the left subtree is a chain of 3.
+	deepLeft := NewBSTNode(10).InsertBST(5).InsertBST(15).InsertBST(3).InsertBST(1)
+
+	tests := []struct {
+		name       string
+		root       *BSTNode
+		wantHeight int
+		balanced   bool
+	}{
+		{"nil tree", nil, 0, true},
+		{"single node", NewBSTNode(1), 1, true},
+		{"BuildBST of sorted values", BuildBST(values), 6, true},
+		{"BuildBST of an even count", BuildBST(values[:10]), 4, true},
+		{"sequential InsertBST of sorted values", degenerate, size, false},
+		{"two nodes", NewBSTNode(1).InsertBST(2), 2, true},
+		{"unbalanced subtree", deepLeft, 4, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.root.Height(); got != tt.wantHeight {
+				t.Errorf("Height() = %d, want %d", got, tt.wantHeight)
+			}
+
+			if got := tt.root.IsBalanced(); got != tt.balanced {
+				t.Errorf("IsBalanced() = %v, want %v", got, tt.balanced)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package factorial
+
+// JobShopSchedulingBruteForce finds the order to run a set of jobs through a
+// fixed sequence of machines that minimizes the makespan (the time at which
+// the last job finishes on the last machine) by trying all n! job orders.
+//
+// jobs[i][m] is the processing time of job i on machine m. Every job visits
+// the machines in the same order, a machine works on one job at a time, and
+// a job can't start on machine m until it has finished on machine m-1. Jobs
+// with fewer entries than others take no time on the missing machines.
+//
+// It returns the best order as a list of job indices along with its
+// makespan. Computing the makespan of one order is O(n · m), so the total is
+// O(n! · n · m). For two machines Johnson's rule finds the optimum in
+// O(n log n), but for three or more machines the problem is NP-hard.
+//
+// An empty job list returns an empty order with a makespan of 0.
+func JobShopSchedulingBruteForce(jobs [][]int) ([]int, int) {
+	n := len(jobs)
+
+	machines := 0
+	for _, job := range jobs {
+		machines = max(machines, len(job))
+	}
+
+	best := make([]int, n)
+	bestMakespan := 0
+	found := false
+
+	// finish[m] is when the most recently scheduled job finishes on machine m.
+	finish := make([]int, machines)
+
+	forEachPermutation(n, func(order []int) {
+		clear(finish)
+
+		for _, j := range order {
+			ready := 0 // When job j finished on the previous machine
+			for m := range machines {
+				duration := 0
+				if m < len(jobs[j]) {
+					duration = jobs[j][m]
+				}
+
+				finish[m] = max(finish[m], ready) + duration
+				ready = finish[m]
+			}
+		}
+
+		makespan := 0
+		if machines > 0 {
+			makespan = finish[machines-1]
+		}
+
+		if !found || makespan < bestMakespan {
+			found = true
+			bestMakespan = makespan
+			copy(best, order)
+		}
+	})
+
+	return best, bestMakespan
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package factorial
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+)
+
+// johnsonMakespan computes the optimal two machine makespan with Johnson's
+// rule to check the brute force result.
+func johnsonMakespan(jobs [][]int) int {
+	var first, second []int
+	for i, job := range jobs {
+		if job[0] < job[1] {
+			first = append(first, i)
+		} else {
+			second = append(second, i)
+		}
+	}
+
+	// Jobs faster on machine 1 go first in increasing machine 1 time, the
+	// rest go last in decreasing machine 2 time.
+	sort.SliceStable(first, func(a, b int) bool { return jobs[first[a]][0] < jobs[first[b]][0] })
+	sort.SliceStable(second, func(a, b int) bool { return jobs[second[a]][1] > jobs[second[b]][1] })
+
+	m1, m2 := 0, 0
+	for _, j := range append(first, second...) {
+		m1 += jobs[j][0]
+		m2 = max(m2, m1) + jobs[j][1]
+	}
+
+	return m2
+}
+
+func TestJobShopSchedulingBruteForce(t *testing.T) {
+	tests := []struct {
+		name         string
+		jobs         [][]int
+		wantMakespan int
+	}{
+		{
+			name:         "empty",
+			jobs:         [][]int{},
+			wantMakespan: 0,
+		},
+		{
+			name:         "single job",
+			jobs:         [][]int{{3, 4, 5}},
+			wantMakespan: 12,
+		},
+		{
+			name:         "single machine",
+			jobs:         [][]int{{3}, {1}, {4}},
+			wantMakespan: 8,
+		},
+		{
+			name: "two machines",
+			jobs: [][]int{
+				{3, 2},
+				{1, 4},
+				{2, 1},
+			},
+			wantMakespan: 8,
+		},
+		{
+			name: "three machines",
+			jobs: [][]int{
+				{5, 4, 4},
+				{2, 6, 3},
+				{4, 3, 5},
+			},
+			wantMakespan: 20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order, makespan := JobShopSchedulingBruteForce(tt.jobs)
+			if makespan != tt.wantMakespan {
+				t.Errorf("JobShopSchedulingBruteForce() makespan = %d, want %d (order %v)", makespan, tt.wantMakespan, order)
+			}
+
+			if len(order) != len(tt.jobs) {
+				t.Errorf("JobShopSchedulingBruteForce() order %v has %d jobs, want %d", order, len(order), len(tt.jobs))
+			}
+		})
+	}
+}
+
+func TestJobShopSchedulingBruteForceMatchesJohnson(t *testing.T) {
+	for n := 2; n <= 7; n++ {
+		t.Run(fmt.Sprintf("jobs=%d", n), func(t *testing.T) {
+			jobs := make([][]int, n)
+			for i := range jobs {
+				jobs[i] = []int{(i*7+3)%10 + 1, (i*5+8)%10 + 1}
+			}
+
+			_, makespan := JobShopSchedulingBruteForce(jobs)
+			if want := johnsonMakespan(jobs); makespan != want {
+				t.Errorf("JobShopSchedulingBruteForce() makespan = %d, want %d", makespan, want)
+			}
+		})
+	}
+}
+
+func BenchmarkJobShopSchedulingBruteForce(b *testing.B) {
+	for n := 1; n <= 8; n++ {
+		jobs := make([][]int, n)
+		for i := range jobs {
+			jobs[i] = []int{(i*7+3)%10 + 1, (i*5+8)%10 + 1}
+		}
+
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			for b.Loop() {
+				_, _ = JobShopSchedulingBruteForce(jobs)
+			}
+		})
+	}
+}
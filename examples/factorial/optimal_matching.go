@@ -0,0 +1,49 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package factorial
+
+// OptimalMatchingBruteForce finds a minimum weight perfect matching between
+// the rows and columns of a square weight matrix by trying all n! ways of
+// pairing them. weights[i][j] is the cost of matching row i with column j.
+//
+// It returns the assignment, where assignment[i] is the column matched to
+// row i, along with its total weight. This is O(n! · n): each of the n!
+// permutations takes O(n) to total up. The Hungarian algorithm solves the
+// same problem in O(n³), which is why brute force is only usable for very
+// small n.
+//
+// The matrix must be square. An empty matrix returns an empty assignment
+// with a total of 0.
+func OptimalMatchingBruteForce(weights [][]int) ([]int, int) {
+	n := len(weights)
+	best := make([]int, n)
+	bestTotal := 0
+	found := false
+
+	forEachPermutation(n, func(perm []int) {
+		total := 0
+		for row, col := range perm {
+			total += weights[row][col]
+		}
+
+		if !found || total < bestTotal {
+			found = true
+			bestTotal = total
+			copy(best, perm)
+		}
+	})
+
+	return best, bestTotal
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package factorial
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// minMatchingDP computes the minimum weight perfect matching with the
+// O(2^n · n) bitmask dynamic program to check the brute force result.
+func minMatchingDP(weights [][]int) int {
+	n := len(weights)
+	dp := make([]int, 1<<n)
+	for mask := 1; mask < len(dp); mask++ {
+		dp[mask] = math.MaxInt
+	}
+
+	for mask := range len(dp) - 1 {
+		if dp[mask] == math.MaxInt {
+			continue
+		}
+
+		// The next row to assign is the number of columns already used.
+		row := 0
+		for m := mask; m != 0; m &= m - 1 {
+			row++
+		}
+
+		for col := range n {
+			if mask&(1<<col) == 0 {
+				next := mask | 1<<col
+				dp[next] = min(dp[next], dp[mask]+weights[row][col])
+			}
+		}
+	}
+
+	return dp[len(dp)-1]
+}
+
+func TestOptimalMatchingBruteForce(t *testing.T) {
+	tests := []struct {
+		name           string
+		weights        [][]int
+		wantAssignment []int
+		wantTotal      int
+	}{
+		{
+			name:           "empty",
+			weights:        [][]int{},
+			wantAssignment: []int{},
+			wantTotal:      0,
+		},
+		{
+			name:           "single",
+			weights:        [][]int{{7}},
+			wantAssignment: []int{0},
+			wantTotal:      7,
+		},
+		{
+			name: "3x3 classic",
+			weights: [][]int{
+				{9, 2, 7},
+				{6, 4, 3},
+				{5, 8, 1},
+			},
+			wantAssignment: []int{1, 0, 2},
+			wantTotal:      9,
+		},
+		{
+			name: "4x4 anti-diagonal",
+			weights: [][]int{
+				{9, 9, 9, 1},
+				{9, 9, 1, 9},
+				{9, 1, 9, 9},
+				{1, 9, 9, 9},
+			},
+			wantAssignment: []int{3, 2, 1, 0},
+			wantTotal:      4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assignment, total := OptimalMatchingBruteForce(tt.weights)
+			if total != tt.wantTotal {
+				t.Errorf("OptimalMatchingBruteForce() total = %d, want %d", total, tt.wantTotal)
+			}
+
+			if fmt.Sprint(assignment) != fmt.Sprint(tt.wantAssignment) {
+				t.Errorf("OptimalMatchingBruteForce() assignment = %v, want %v", assignment, tt.wantAssignment)
+			}
+		})
+	}
+}
+
+func TestOptimalMatchingBruteForceMatchesDP(t *testing.T) {
+	for n := 3; n <= 5; n++ {
+		for seed := range 5 {
+			t.Run(fmt.Sprintf("%dx%d_seed_%d", n, n, seed), func(t *testing.T) {
+				weights := make([][]int, n)
+				for i := range weights {
+					weights[i] = make([]int, n)
+					for j := range weights[i] {
+						weights[i][j] = (i*31+j*17+seed*13+i*j*7)%50 + 1
+					}
+				}
+
+				assignment, total := OptimalMatchingBruteForce(weights)
+				if want := minMatchingDP(weights); total != want {
+					t.Errorf("OptimalMatchingBruteForce() total = %d, want %d", total, want)
+				}
+
+				// The assignment must be a permutation that adds up to the total.
+				used := make(map[int]bool)
+				sum := 0
+				for row, col := range assignment {
+					used[col] = true
+					sum += weights[row][col]
+				}
+
+				if len(used) != n || sum != total {
+					t.Errorf("OptimalMatchingBruteForce() assignment %v is not a valid matching with total %d", assignment, total)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkOptimalMatchingBruteForce(b *testing.B) {
+	for n := 1; n <= 8; n++ {
+		weights := make([][]int, n)
+		for i := range weights {
+			weights[i] = make([]int, n)
+			for j := range weights[i] {
+				weights[i][j] = (i*31+j*17)%100 + 1
+			}
+		}
+
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			for b.Loop() {
+				_, _ = OptimalMatchingBruteForce(weights)
+			}
+		})
+	}
+}
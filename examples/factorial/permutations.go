@@ -0,0 +1,51 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package factorial
+
+// forEachPermutation calls visit once for each of the n! orderings of the
+// indices 0..n-1, using Heap's algorithm. Each permutation differs from the
+// previous one by a single swap, so generating the next ordering is O(1)
+// amortized and the total work is dominated by the n! calls to visit.
+//
+// The slice passed to visit is reused between calls and must not be
+// retained or modified.
+func forEachPermutation(n int, visit func(perm []int)) {
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+
+	visit(perm)
+
+	// c is the iterative form of the recursion stack in Heap's algorithm.
+	c := make([]int, n)
+	for i := 1; i < n; {
+		if c[i] < i {
+			if i%2 == 0 {
+				perm[0], perm[i] = perm[i], perm[0]
+			} else {
+				perm[c[i]], perm[i] = perm[i], perm[c[i]]
+			}
+
+			visit(perm)
+
+			c[i]++
+			i = 1
+		} else {
+			c[i] = 0
+			i++
+		}
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package factorial
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestForEachPermutation(t *testing.T) {
+	wantCounts := []int{1, 1, 2, 6, 24, 120, 720}
+
+	for n, want := range wantCounts {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			seen := make(map[string]bool)
+			forEachPermutation(n, func(perm []int) {
+				seen[fmt.Sprint(perm)] = true
+			})
+
+			if len(seen) != want {
+				t.Errorf("forEachPermutation(%d) visited %d distinct permutations, want %d", n, len(seen), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inverseackermann
+
+import "math/rand/v2"
+
+// UnionFind is a disjoint-set forest over the elements 0 through n-1 using
+// union by size and path halving.
+//
+// Union by size keeps every tree O(log n) tall, and path halving points
+// every other node on a Find path at its grandparent, shortening the path
+// for later calls without a second pass. Tarjan and van Leeuwen showed that
+// this combination makes any sequence of m operations O(m α(n)), where α is
+// the inverse Ackermann function, which is at most 4 for any n that fits in
+// memory. The nlogstar package's UnionFind uses link-by-rank and full path
+// compression instead, and is analysed with the looser Hopcroft-Ullman
+// O(log* n) bound.
+type UnionFind struct {
+	parent []int
+	size   []int
+	count  int
+}
+
+// NewUnionFind creates a UnionFind with n elements, each in its own set.
+func NewUnionFind(n int) *UnionFind {
+	uf := &UnionFind{
+		parent: make([]int, n),
+		size:   make([]int, n),
+		count:  n,
+	}
+
+	for i := range uf.parent {
+		uf.parent[i] = i
+		uf.size[i] = 1
+	}
+
+	return uf
+}
+
+// Find returns the representative of the set containing x - O(α(n))
+// amortized.
+func (uf *UnionFind) Find(x int) int {
+	for uf.parent[x] != x {
+		uf.parent[x] = uf.parent[uf.parent[x]]
+		x = uf.parent[x]
+	}
+
+	return x
+}
+
+// Union merges the sets containing x and y, attaching the smaller tree
+// beneath the larger - O(α(n)) amortized. It reports whether the two were
+// in different sets.
+func (uf *UnionFind) Union(x, y int) bool {
+	rootX, rootY := uf.Find(x), uf.Find(y)
+	if rootX == rootY {
+		return false
+	}
+
+	if uf.size[rootX] < uf.size[rootY] {
+		rootX, rootY = rootY, rootX
+	}
+
+	uf.parent[rootY] = rootX
+	uf.size[rootX] += uf.size[rootY]
+	uf.count--
+
+	return true
+}
+
+// Connected reports whether x and y are in the same set - O(α(n))
+// amortized.
+func (uf *UnionFind) Connected(x, y int) bool {
+	return uf.Find(x) == uf.Find(y)
+}
+
+// SetSize returns the number of elements in the set containing x - O(α(n))
+// amortized.
+func (uf *UnionFind) SetSize(x int) int {
+	return uf.size[uf.Find(x)]
+}
+
+// Count returns the number of disjoint sets.
+func (uf *UnionFind) Count() int {
+	return uf.count
+}
+
+// PerformOperations performs n operations on n elements, two unions for
+// every connectivity query, and returns how many of the queries found their
+// two elements already connected. Each operation is O(α(n)) amortized, so
+// the whole run is O(n α(n)).
+//
+// The pairs are drawn from a fixed seed, so the result is repeatable. With
+// 2n/3 random unions a large component forms part way through, so later
+// queries see both outcomes. It returns 0 if n is not positive.
+func PerformOperations(n int) int {
+	if n <= 0 {
+		return 0
+	}
+
+	uf := NewUnionFind(n)
+	r := rand.New(rand.NewPCG(1, uint64(n)))
+
+	connected := 0
+	for i := range n {
+		x, y := r.IntN(n), r.IntN(n)
+		if i%3 != 2 {
+			uf.Union(x, y)
+		} else if uf.Connected(x, y) {
+			connected++
+		}
+	}
+
+	return connected
+}
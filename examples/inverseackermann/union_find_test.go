@@ -0,0 +1,168 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inverseackermann
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+// naiveSets tracks set membership by relabelling every element on each
+// union, for checking UnionFind in O(n) per operation.
+type naiveSets []int
+
+func newNaiveSets(n int) naiveSets {
+	s := make(naiveSets, n)
+	for i := range s {
+		s[i] = i
+	}
+
+	return s
+}
+
+func (s naiveSets) union(x, y int) {
+	from, to := s[y], s[x]
+	for i, label := range s {
+		if label == from {
+			s[i] = to
+		}
+	}
+}
+
+func (s naiveSets) size(x int) int {
+	count := 0
+	for _, label := range s {
+		if label == s[x] {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestUnionFind(t *testing.T) {
+	uf := NewUnionFind(8)
+
+	unions := []struct {
+		x, y int
+		want bool
+	}{
+		{0, 1, true},
+		{1, 2, true},
+		{2, 0, false},
+		{5, 6, true},
+		{3, 3, false},
+		{6, 0, true},
+	}
+
+	for _, u := range unions {
+		if got := uf.Union(u.x, u.y); got != u.want {
+			t.Errorf("Union(%d, %d) = %t, want %t", u.x, u.y, got, u.want)
+		}
+	}
+
+	tests := []struct {
+		x, y      int
+		connected bool
+		size      int
+	}{
+		{0, 6, true, 5},
+		{2, 5, true, 5},
+		{3, 4, false, 1},
+		{7, 0, false, 1},
+		{4, 4, true, 1},
+	}
+
+	for _, tt := range tests {
+		if got := uf.Connected(tt.x, tt.y); got != tt.connected {
+			t.Errorf("Connected(%d, %d) = %t, want %t", tt.x, tt.y, got, tt.connected)
+		}
+
+		if got := uf.SetSize(tt.x); got != tt.size {
+			t.Errorf("SetSize(%d) = %d, want %d", tt.x, got, tt.size)
+		}
+	}
+
+	if got := uf.Count(); got != 4 {
+		t.Errorf("Count() = %d, want 4", got)
+	}
+}
+
+func TestUnionFindMatchesNaive(t *testing.T) {
+	const n = 300
+
+	r := rand.New(rand.NewPCG(1, 0))
+	uf := NewUnionFind(n)
+	naive := newNaiveSets(n)
+	count := n
+
+	for range 2000 {
+		x, y := r.IntN(n), r.IntN(n)
+
+		if r.IntN(3) == 0 {
+			want := naive[x] != naive[y]
+			if got := uf.Union(x, y); got != want {
+				t.Fatalf("Union(%d, %d) = %t, want %t", x, y, got, want)
+			}
+
+			if want {
+				naive.union(x, y)
+				count--
+			}
+
+			continue
+		}
+
+		if got, want := uf.Connected(x, y), naive[x] == naive[y]; got != want {
+			t.Fatalf("Connected(%d, %d) = %t, want %t", x, y, got, want)
+		}
+
+		if got, want := uf.SetSize(x), naive.size(x); got != want {
+			t.Fatalf("SetSize(%d) = %d, want %d", x, got, want)
+		}
+	}
+
+	if got := uf.Count(); got != count {
+		t.Errorf("Count() = %d, want %d", got, count)
+	}
+}
+
+func TestPerformOperations(t *testing.T) {
+	if got := PerformOperations(-1); got != 0 {
+		t.Errorf("PerformOperations(-1) = %d, want 0", got)
+	}
+
+	for _, n := range []int{0, 1, 2, 10, 1000} {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			naive := newNaiveSets(n)
+			r := rand.New(rand.NewPCG(1, uint64(n)))
+
+			want := 0
+			for i := range n {
+				x, y := r.IntN(n), r.IntN(n)
+				if i%3 != 2 {
+					naive.union(x, y)
+				} else if naive[x] == naive[y] {
+					want++
+				}
+			}
+
+			if got := PerformOperations(n); got != want {
+				t.Errorf("PerformOperations(%d) = %d, want %d", n, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+import "math"
+
+// BucketSort sorts float64 values by distributing them into n buckets that
+// evenly divide the range between the smallest and largest value, sorting
+// each bucket with an insertion sort, and concatenating the buckets. A sorted
+// copy is returned and the input is left untouched.
+//
+// The expected O(n) running time relies on the values being roughly
+// uniformly distributed over their range. Then each bucket holds O(1) values
+// on average and the insertion sorts are cheap. The result is still correct
+// for any distribution of finite values, but if many values land in the same
+// bucket, the insertion sort on that bucket degrades toward O(n²).
+func BucketSort(arr []float64) []float64 {
+	// Create a copy to avoid modifying the original array
+	result := make([]float64, len(arr))
+	copy(result, arr)
+
+	n := len(result)
+	if n < 2 {
+		return result
+	}
+
+	// Find the range of the values so they can be spread across the buckets.
+	minVal, maxVal := result[0], result[0]
+	for _, v := range result[1:] {
+		minVal = min(minVal, v)
+		maxVal = max(maxVal, v)
+	}
+
+	// All values equal, already sorted.
+	if minVal == maxVal {
+		return result
+	}
+
+	// The range of two finite values can still overflow to +Inf, for example
+	// [-1e308, 1e308]. Halving every value keeps it finite and preserves the
+	// order, which is all the bucket index needs.
+	scale := 1.0
+	width := maxVal - minVal
+	if math.IsInf(width, 1) {
+		scale = 0.5
+		width = maxVal*scale - minVal*scale
+	}
+
+	// Distribute the values into n buckets - O(n)
+	buckets := make([][]float64, n)
+	for _, v := range result {
+		// Divide before multiplying by n so the product stays within [0, n].
+		pos := (v*scale - minVal*scale) / width * float64(n)
+		// The maximum value lands exactly on n, keep it in the last bucket.
+		idx := min(max(int(pos), 0), n-1)
+
+		buckets[idx] = append(buckets[idx], v)
+	}
+
+	// Sort each bucket and concatenate them back together - O(n) expected
+	pos := 0
+	for _, bucket := range buckets {
+		insertionSortFloats(bucket)
+		pos += copy(result[pos:], bucket)
+	}
+
+	return result
+}
+
+// insertionSortFloats sorts a small slice of float64 values in place.
+func insertionSortFloats(arr []float64) {
+	for i := 1; i < len(arr); i++ {
+		key := arr[i]
+		j := i - 1
+		for j >= 0 && arr[j] > key {
+			arr[j+1] = arr[j]
+			j--
+		}
+
+		arr[j+1] = key
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBucketSort(t *testing.T) {
+	tests := []struct {
+		name string
+		arr  []float64
+	}{
+		{"empty array", []float64{}},
+		{"single element", []float64{3.5}},
+		{"two elements", []float64{2.5, 1.5}},
+		{"already sorted", []float64{0.1, 0.2, 0.3, 0.4}},
+		{"reverse sorted", []float64{0.9, 0.7, 0.5, 0.3, 0.1}},
+		{"uniform in unit interval", []float64{0.78, 0.17, 0.39, 0.26, 0.72, 0.94, 0.21, 0.12, 0.23, 0.68}},
+		{"duplicates", []float64{1.5, 0.5, 1.5, 0.5, 1.0}},
+		{"all equal", []float64{4.2, 4.2, 4.2}},
+		{"negative values", []float64{-1.5, 3.25, 0, -7.75, 2}},
+		{"clustered non-uniform", []float64{1000, 0.001, 0.002, 0.003, 0.0015, 0.0025, 999}},
+		{"range overflows float64", []float64{-1e308, 1e308, 0}},
+		{"float64 extremes", []float64{math.MaxFloat64, -math.MaxFloat64, 1, -1, math.SmallestNonzeroFloat64, 0}},
+		{"tiny range", []float64{math.SmallestNonzeroFloat64, 0, math.SmallestNonzeroFloat64, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := make([]float64, len(tt.arr))
+			copy(want, tt.arr)
+			sort.Float64s(want)
+
+			got := BucketSort(tt.arr)
+			if !cmp.Equal(got, want) {
+				t.Errorf("BucketSort(%v) = %v, want %v", tt.arr, got, want)
+			}
+		})
+	}
+}
+
+func TestBucketSortMatchesSortFloat64s(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	distributions := map[string]func() float64{
+		"uniform":     rng.Float64,
+		"exponential": rng.ExpFloat64,
+		"normal":      rng.NormFloat64,
+		"skewed": func() float64 {
+			return math.Pow(rng.Float64(), 8)
+		},
+	}
+
+	for name, gen := range distributions {
+		for _, size := range []int{10, 1000, 10000} {
+			t.Run(fmt.Sprintf("%s_%d", name, size), func(t *testing.T) {
+				arr := make([]float64, size)
+				for i := range arr {
+					arr[i] = gen()
+				}
+
+				want := make([]float64, len(arr))
+				copy(want, arr)
+				sort.Float64s(want)
+
+				got := BucketSort(arr)
+				if !cmp.Equal(got, want) {
+					t.Errorf("BucketSort() of %d %s values does not match sort.Float64s", size, name)
+				}
+			})
+		}
+	}
+}
+
+func TestBucketSortDoesNotModifyOriginal(t *testing.T) {
+	original := []float64{0.3, 0.1, 0.2}
+	originalCopy := []float64{0.3, 0.1, 0.2}
+
+	BucketSort(original)
+
+	if !cmp.Equal(original, originalCopy) {
+		t.Errorf("BucketSort modified original array: got %v, want %v", original, originalCopy)
+	}
+}
+
+func BenchmarkBucketSort(b *testing.B) {
+	sizes := []int{1000, 10000, 100000, 1000000}
+
+	for _, size := range sizes {
+		// Uniformly distributed values for the expected O(n) case.
+		arr := make([]float64, size)
+		for i := range arr {
+			arr[i] = float64(testIntVals[i])
+		}
+
+		b.Run(fmt.Sprintf("size_%d", size), func(b *testing.B) {
+			for b.Loop() {
+				BucketSort(arr)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+import "fmt"
+
+const (
+	// countingSortRangeFactor bounds the value range k that CountingSort
+	// accepts to this multiple of n, keeping O(n + k) honestly O(n).
+	countingSortRangeFactor = 16
+
+	// countingSortMinRange is the value range always accepted so that small
+	// inputs with modest values are not rejected.
+	countingSortMinRange = 1024
+)
+
+// CountingSort sorts integers by counting how many times each value occurs
+// and then writing the values back out in order. A sorted copy is returned
+// and the input is left untouched.
+//
+// The running time is O(n + k) where k is the range of the values (max - min
+// + 1). Negative values are handled by offsetting every value by the minimum.
+// When k is bounded by a constant multiple of n this is O(n), which is why it
+// beats the O(n log n) comparison sorts. If k is pathologically large
+// compared to n (more than countingSortRangeFactor*n, and more than
+// countingSortMinRange), the count array would dominate both time and memory,
+// so an error is returned instead.
+func CountingSort(arr []int) ([]int, error) {
+	result := make([]int, len(arr))
+	if len(arr) == 0 {
+		return result, nil
+	}
+
+	// Find the range of values - O(n)
+	minVal, maxVal := arr[0], arr[0]
+	for _, v := range arr[1:] {
+		minVal = min(minVal, v)
+		maxVal = max(maxVal, v)
+	}
+
+	// Unsigned subtraction avoids overflow when the values span most of
+	// the int range.
+	k := uint64(maxVal) - uint64(minVal) + 1
+	limit := uint64(max(countingSortRangeFactor*len(arr), countingSortMinRange))
+	if k == 0 || k > limit {
+		return nil, fmt.Errorf("value range [%d, %d] is too large for %d values", minVal, maxVal, len(arr))
+	}
+
+	// Count the occurrences of each value - O(n)
+	counts := make([]int, k)
+	for _, v := range arr {
+		counts[v-minVal]++
+	}
+
+	// Write each value out as many times as it was counted - O(n + k)
+	pos := 0
+	for offset, count := range counts {
+		for range count {
+			result[pos] = offset + minVal
+			pos++
+		}
+	}
+
+	return result, nil
+}
+
+// CountingSortBounded sorts integers known to lie in [0, maxVal] and returns
+// a sorted copy, leaving the input untouched. It is CountingSort for the
+// common case where the caller already knows the bound: there is no pass to
+// find the range, so the cost is exactly O(n + k) with k = maxVal + 1. With
+// k fixed, for example bytes or small enum values, the time grows linearly
+// with n no matter how large n gets, which is below the Ω(n log n) bound that
+// only applies to sorts that compare elements.
+//
+// An error is returned if maxVal is negative or any value lies outside
+// [0, maxVal]. Like CountingSort, k may be at most countingSortRangeFactor*n
+// or countingSortMinRange, whichever is larger, so an oversized bound such
+// as math.MaxInt is an error rather than a huge count array.
+func CountingSortBounded(arr []int, maxVal int) ([]int, error) {
+	if maxVal < 0 {
+		return nil, fmt.Errorf("maxVal %d must not be negative", maxVal)
+	}
+
+	// Compare maxVal rather than k so maxVal+1 can not overflow.
+	if limit := max(countingSortRangeFactor*len(arr), countingSortMinRange); maxVal >= limit {
+		return nil, fmt.Errorf("maxVal %d is too large for %d values", maxVal, len(arr))
+	}
+
+	// Count the occurrences of each value - O(n)
+	counts := make([]int, maxVal+1)
+	for _, v := range arr {
+		if v < 0 || v > maxVal {
+			return nil, fmt.Errorf("value %d is outside [0, %d]", v, maxVal)
+		}
+
+		counts[v]++
+	}
+
+	// Write each value out as many times as it was counted - O(n + k)
+	result := make([]int, 0, len(arr))
+	for v, count := range counts {
+		for range count {
+			result = append(result, v)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,235 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCountingSort(t *testing.T) {
+	tests := []struct {
+		name string
+		arr  []int
+	}{
+		{"empty array", []int{}},
+		{"single element", []int{5}},
+		{"already sorted", []int{1, 2, 3, 4, 5}},
+		{"reverse sorted", []int{5, 4, 3, 2, 1}},
+		{"duplicates", []int{3, 1, 3, 1, 3, 2}},
+		{"negative numbers", []int{-1, -5, 0, 3, -2}},
+		{"all negative", []int{-10, -30, -20, -30}},
+		{"all equal", []int{7, 7, 7, 7}},
+		{"large offset", []int{1000005, 1000001, 1000003}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := make([]int, len(tt.arr))
+			copy(want, tt.arr)
+			sort.Ints(want)
+
+			got, err := CountingSort(tt.arr)
+			if err != nil {
+				t.Fatalf("CountingSort(%v) returned error: %v", tt.arr, err)
+			}
+
+			if !cmp.Equal(got, want) {
+				t.Errorf("CountingSort(%v) = %v, want %v", tt.arr, got, want)
+			}
+		})
+	}
+}
+
+func TestCountingSortMatchesSortInts(t *testing.T) {
+	for _, size := range []int{10, 100, 10000} {
+		t.Run(fmt.Sprintf("size_%d", size), func(t *testing.T) {
+			// Values in [-size, size) so the range stays proportional to n.
+			arr := make([]int, size)
+			for i := range arr {
+				arr[i] = testIntVals[i]%(2*size) - size
+			}
+
+			want := make([]int, len(arr))
+			copy(want, arr)
+			sort.Ints(want)
+
+			got, err := CountingSort(arr)
+			if err != nil {
+				t.Fatalf("CountingSort() returned error: %v", err)
+			}
+
+			if !cmp.Equal(got, want) {
+				t.Errorf("CountingSort() of size %d does not match sort.Ints", size)
+			}
+		})
+	}
+}
+
+func TestCountingSortRangeGuard(t *testing.T) {
+	tests := []struct {
+		name    string
+		arr     []int
+		wantErr bool
+	}{
+		{"small range", []int{0, 1023}, false},
+		{"range just over the minimum", []int{0, 1024}, true},
+		{"range proportional to n", func() []int {
+			arr := make([]int, 1000)
+			for i := range arr {
+				arr[i] = i * countingSortRangeFactor
+			}
+
+			return arr
+		}(), false},
+		{"sparse huge values", []int{0, 1 << 40, 5}, true},
+		{"full int range", []int{math.MinInt, math.MaxInt}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CountingSort(tt.arr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CountingSort() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCountingSortDoesNotModifyOriginal(t *testing.T) {
+	original := []int{3, 1, 2}
+	originalCopy := []int{3, 1, 2}
+
+	if _, err := CountingSort(original); err != nil {
+		t.Fatalf("CountingSort() returned error: %v", err)
+	}
+
+	if !cmp.Equal(original, originalCopy) {
+		t.Errorf("CountingSort modified original array: got %v, want %v", original, originalCopy)
+	}
+}
+
+func TestCountingSortBounded(t *testing.T) {
+	tests := []struct {
+		name   string
+		arr    []int
+		maxVal int
+	}{
+		{"empty array", []int{}, 10},
+		{"nil array", nil, 0},
+		{"single element", []int{5}, 5},
+		{"already sorted", []int{1, 2, 3, 4, 5}, 5},
+		{"reverse sorted", []int{5, 4, 3, 2, 1}, 9},
+		{"duplicates", []int{3, 1, 3, 1, 3, 2}, 3},
+		{"zeros and max", []int{255, 0, 255, 0}, 255},
+		{"all equal", []int{7, 7, 7, 7}, 7},
+		{"maxVal at range limit", []int{1023, 0}, countingSortMinRange - 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := make([]int, len(tt.arr))
+			copy(want, tt.arr)
+			sort.Ints(want)
+
+			got, err := CountingSortBounded(tt.arr, tt.maxVal)
+			if err != nil {
+				t.Fatalf("CountingSortBounded(%v, %d) returned error: %v", tt.arr, tt.maxVal, err)
+			}
+
+			if !cmp.Equal(got, want) {
+				t.Errorf("CountingSortBounded(%v, %d) = %v, want %v", tt.arr, tt.maxVal, got, want)
+			}
+		})
+	}
+}
+
+func TestCountingSortBoundedMatchesSortInts(t *testing.T) {
+	// A fixed value range with growing n, as in the Linear benchmark.
+	const maxVal = 255
+
+	for _, size := range []int{10, 1000, 10000} {
+		t.Run(fmt.Sprintf("size_%d", size), func(t *testing.T) {
+			arr := make([]int, size)
+			for i := range arr {
+				arr[i] = testIntVals[i] % (maxVal + 1)
+			}
+
+			original := make([]int, len(arr))
+			copy(original, arr)
+
+			want := make([]int, len(arr))
+			copy(want, arr)
+			sort.Ints(want)
+
+			got, err := CountingSortBounded(arr, maxVal)
+			if err != nil {
+				t.Fatalf("CountingSortBounded() returned error: %v", err)
+			}
+
+			if !cmp.Equal(got, want) {
+				t.Errorf("CountingSortBounded() of size %d does not match sort.Ints", size)
+			}
+
+			if !cmp.Equal(arr, original) {
+				t.Errorf("CountingSortBounded modified its input")
+			}
+		})
+	}
+}
+
+func TestCountingSortBoundedErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		arr    []int
+		maxVal int
+	}{
+		{"negative maxVal", []int{}, -1},
+		{"negative value", []int{1, -1}, 5},
+		{"value above maxVal", []int{1, 6}, 5},
+		{"maxVal is MaxInt", []int{1, 2}, math.MaxInt},
+		{"maxVal above range limit", []int{1, 2}, countingSortMinRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := CountingSortBounded(tt.arr, tt.maxVal); err == nil {
+				t.Errorf("CountingSortBounded(%v, %d) = %v, should have returned an error", tt.arr, tt.maxVal, got)
+			}
+		})
+	}
+}
+
+func BenchmarkCountingSort(b *testing.B) {
+	sizes := []int{1000, 10000, 100000, 1000000}
+
+	for _, size := range sizes {
+		// Bounded range data: values in [0, size).
+		arr := make([]int, size)
+		for i := range arr {
+			arr[i] = testIntVals[i] % size
+		}
+
+		b.Run(fmt.Sprintf("size_%d", size), func(b *testing.B) {
+			for b.Loop() {
+				_, _ = CountingSort(arr)
+			}
+		})
+	}
+}
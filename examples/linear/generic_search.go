@@ -0,0 +1,50 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+// IndexOf returns the index of the first occurrence of v in s, or -1 if v is
+// not present. This is the generic form of Search and is O(n) for the same
+// reason: in the worst case every element has to be compared before we can
+// say the value is absent.
+func IndexOf[T comparable](s []T, v T) int {
+	// Check each element from the front until a match is found
+	for i, val := range s {
+		if val == v {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// LastIndexOf returns the index of the last occurrence of v in s, or -1 if v
+// is not present. It scans from the back, so it is O(n) in the worst case
+// just like IndexOf.
+func LastIndexOf[T comparable](s []T, v T) int {
+	// Check each element from the back until a match is found
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == v {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Contains reports whether v is present in s. It stops at the first match,
+// but an absent value still requires examining all n elements, making it O(n).
+func Contains[T comparable](s []T, v T) bool {
+	return IndexOf(s, v) >= 0
+}
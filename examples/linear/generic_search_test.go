@@ -0,0 +1,105 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGenericSearchInts(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         []int
+		v         int
+		wantIndex int
+		wantLast  int
+		wantFound bool
+	}{
+		{"found at beginning", []int{1, 2, 3, 4, 5}, 1, 0, 0, true},
+		{"found at end", []int{1, 2, 3, 4, 5}, 5, 4, 4, true},
+		{"not found", []int{1, 2, 3, 4, 5}, 6, -1, -1, false},
+		{"empty slice", []int{}, 1, -1, -1, false},
+		{"nil slice", nil, 1, -1, -1, false},
+		{"duplicates", []int{7, 2, 7, 3, 7, 4}, 7, 0, 4, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IndexOf(tt.s, tt.v); got != tt.wantIndex {
+				t.Errorf("IndexOf(%v, %d) = %d, want %d", tt.s, tt.v, got, tt.wantIndex)
+			}
+
+			if got := LastIndexOf(tt.s, tt.v); got != tt.wantLast {
+				t.Errorf("LastIndexOf(%v, %d) = %d, want %d", tt.s, tt.v, got, tt.wantLast)
+			}
+
+			if got := Contains(tt.s, tt.v); got != tt.wantFound {
+				t.Errorf("Contains(%v, %d) = %t, want %t", tt.s, tt.v, got, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestGenericSearchStrings(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         []string
+		v         string
+		wantIndex int
+		wantLast  int
+		wantFound bool
+	}{
+		{"found in middle", []string{"a", "b", "c"}, "b", 1, 1, true},
+		{"not found", []string{"a", "b", "c"}, "z", -1, -1, false},
+		{"empty string value", []string{"a", "", "c"}, "", 1, 1, true},
+		{"empty slice", []string{}, "a", -1, -1, false},
+		{"duplicates", []string{"go", "rust", "go", "go"}, "go", 0, 3, true},
+		{"case sensitive", []string{"Go", "GO"}, "go", -1, -1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IndexOf(tt.s, tt.v); got != tt.wantIndex {
+				t.Errorf("IndexOf(%q, %q) = %d, want %d", tt.s, tt.v, got, tt.wantIndex)
+			}
+
+			if got := LastIndexOf(tt.s, tt.v); got != tt.wantLast {
+				t.Errorf("LastIndexOf(%q, %q) = %d, want %d", tt.s, tt.v, got, tt.wantLast)
+			}
+
+			if got := Contains(tt.s, tt.v); got != tt.wantFound {
+				t.Errorf("Contains(%q, %q) = %t, want %t", tt.s, tt.v, got, tt.wantFound)
+			}
+		})
+	}
+}
+
+// BenchmarkIndexOfAbsent searches for a value that is not present, forcing
+// the worst case where every element is examined.
+func BenchmarkIndexOfAbsent(b *testing.B) {
+	for _, n := range []int{1000, 10000, 100000} {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = i
+		}
+
+		b.Run(fmt.Sprintf("IndexOf-%d", n), func(b *testing.B) {
+			for b.Loop() {
+				_ = IndexOf(s, -1)
+			}
+		})
+	}
+}
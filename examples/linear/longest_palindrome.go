@@ -0,0 +1,81 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+// manacherSeparator marks the gaps between characters in the string
+// Manacher's algorithm works on. It is not a valid rune, so it can never
+// match a real character.
+const manacherSeparator rune = -1
+
+// LongestPalindrome returns the longest palindromic substring of s in O(n)
+// time using Manacher's algorithm. Characters are compared as runes, so
+// multi-byte UTF-8 characters count as one character. If several
+// palindromes share the longest length, the leftmost is returned. The empty
+// string returns "".
+//
+// A separator is placed between each pair of characters and at both ends,
+// so "abba" becomes "|a|b|b|a|" and every palindrome, even or odd length,
+// has a single center. Scanning left to right, the algorithm remembers the
+// palindrome reaching furthest right. A center inside it starts from the
+// radius of its mirror image on the other side, which is already known,
+// instead of from zero. Every extra comparison then pushes that right edge
+// further, and the edge only moves right, so the comparisons total O(n).
+// Expanding from every center without that reuse is O(n²); see
+// quadratic.LongestPalindromeExpand.
+func LongestPalindrome(s string) string {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return ""
+	}
+
+	// Interleave the separators: even positions are gaps, odd ones runes
+	t := make([]rune, 2*len(runes)+1)
+	for i := range t {
+		t[i] = manacherSeparator
+		if i%2 == 1 {
+			t[i] = runes[i/2]
+		}
+	}
+
+	// radius[i] is how far the palindrome centered at t[i] reaches to each
+	// side, which is also its length in the original string
+	radius := make([]int, len(t))
+	center, right := 0, 0
+	bestCenter := 0
+
+	for i := range t {
+		// Start from the mirror's radius, capped by the right edge
+		if i < right {
+			radius[i] = min(right-i, radius[2*center-i])
+		}
+
+		// Expand past what the mirror guarantees
+		for i-radius[i]-1 >= 0 && i+radius[i]+1 < len(t) && t[i-radius[i]-1] == t[i+radius[i]+1] {
+			radius[i]++
+		}
+
+		if i+radius[i] > right {
+			center, right = i, i+radius[i]
+		}
+
+		if radius[i] > radius[bestCenter] {
+			bestCenter = i
+		}
+	}
+
+	start := (bestCenter - radius[bestCenter]) / 2
+
+	return string(runes[start : start+radius[bestCenter]])
+}
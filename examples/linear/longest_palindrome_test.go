@@ -0,0 +1,108 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// bruteForceLongestPalindrome checks every substring, longest first, and
+// returns the leftmost palindrome of the greatest length.
+func bruteForceLongestPalindrome(s string) string {
+	runes := []rune(s)
+	for length := len(runes); length > 0; length-- {
+		for start := 0; start+length <= len(runes); start++ {
+			isPalindrome := true
+			for i := range length / 2 {
+				if runes[start+i] != runes[start+length-1-i] {
+					isPalindrome = false
+
+					break
+				}
+			}
+
+			if isPalindrome {
+				return string(runes[start : start+length])
+			}
+		}
+	}
+
+	return ""
+}
+
+func TestLongestPalindrome(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty string", "", ""},
+		{"single character", "x", "x"},
+		{"odd length", "xabcbay", "abcba"},
+		{"even length", "xabbay", "abba"},
+		{"whole string odd", "racecar", "racecar"},
+		{"whole string even", "abccba", "abccba"},
+		{"no palindrome longer than one", "abcdef", "a"},
+		{"two characters equal", "aa", "aa"},
+		{"two characters different", "ab", "a"},
+		{"all the same", "aaaaa", "aaaaa"},
+		{"leftmost of equal length", "abaxcdc", "aba"},
+		{"palindrome at the end", "abcdedc", "cdedc"},
+		{"nested palindromes", "abacabadabacaba", "abacabadabacaba"},
+		{"multi-byte runes", "xéàéy", "éàé"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LongestPalindrome(tt.s); got != tt.want {
+				t.Errorf("LongestPalindrome(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLongestPalindromeMatchesBruteForce(t *testing.T) {
+	for _, size := range []int{1, 2, 10, 100, 300} {
+		t.Run(fmt.Sprintf("size_%d", size), func(t *testing.T) {
+			// A three letter alphabet makes palindromes of many lengths.
+			var sb strings.Builder
+			for i := range size {
+				sb.WriteByte(byte('a' + testIntVals[i]%3))
+			}
+
+			s := sb.String()
+			if got, want := LongestPalindrome(s), bruteForceLongestPalindrome(s); got != want {
+				t.Errorf("LongestPalindrome(%q) = %q, want %q", s, got, want)
+			}
+		})
+	}
+}
+
+func BenchmarkLongestPalindrome(b *testing.B) {
+	sizes := []int{100, 1000, 10000, 100000}
+
+	for _, size := range sizes {
+		// Worst case for expanding around each center
+		s := strings.Repeat("a", size)
+
+		b.Run(fmt.Sprintf("size_%d", size), func(b *testing.B) {
+			for b.Loop() {
+				LongestPalindrome(s)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+// MaxSubarraySum returns the largest sum of any non-empty contiguous
+// subarray of arr in O(n) time using Kadane's algorithm. It returns 0 for an
+// empty array. When every element is negative the best subarray is the
+// single least negative element, so that element is returned.
+func MaxSubarraySum(arr []int) int {
+	sum, _, _, _ := MaxSubarray(arr)
+
+	return sum
+}
+
+// MaxSubarray performs O(n) search for the maximum sum contiguous subarray
+// using Kadane's algorithm. It returns the sum along with start and end such
+// that arr[start:end] is the subarray. The bool is false, and the other
+// results zero, if arr is empty.
+//
+// This is the classic single pass dynamic programming example: the best
+// subarray ending at index i is either arr[i] alone or arr[i] appended to
+// the best subarray ending at i-1, whichever is larger. Carrying that one
+// running value forward replaces the O(n²) check of every start and end
+// pair. If several subarrays share the maximum sum, the one that ends first
+// is returned.
+func MaxSubarray(arr []int) (int, int, int, bool) {
+	if len(arr) == 0 {
+		return 0, 0, 0, false
+	}
+
+	bestSum, bestStart, bestEnd := arr[0], 0, 1
+	curSum, curStart := arr[0], 0
+
+	for i := 1; i < len(arr); i++ {
+		// A negative running sum can only drag down what follows, so start
+		// a new subarray here instead of extending it
+		if curSum < 0 {
+			curSum, curStart = arr[i], i
+		} else {
+			curSum += arr[i]
+		}
+
+		if curSum > bestSum {
+			bestSum, bestStart, bestEnd = curSum, curStart, i+1
+		}
+	}
+
+	return bestSum, bestStart, bestEnd, true
+}
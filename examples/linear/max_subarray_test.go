@@ -0,0 +1,140 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+import (
+	"fmt"
+	"testing"
+)
+
+// bruteForceMaxSubarray checks every start and end pair in O(n²) and returns
+// the maximum sum, or 0 for an empty array.
+func bruteForceMaxSubarray(arr []int) int {
+	if len(arr) == 0 {
+		return 0
+	}
+
+	best := arr[0]
+	for start := range arr {
+		sum := 0
+		for end := start; end < len(arr); end++ {
+			sum += arr[end]
+			best = max(best, sum)
+		}
+	}
+
+	return best
+}
+
+func TestMaxSubarraySum(t *testing.T) {
+	tests := []struct {
+		name string
+		arr  []int
+		want int
+	}{
+		{"empty array", []int{}, 0},
+		{"nil array", nil, 0},
+		{"single positive", []int{5}, 5},
+		{"single negative", []int{-5}, -5},
+		{"classic example", []int{-2, 1, -3, 4, -1, 2, 1, -5, 4}, 6},
+		{"all positive", []int{1, 2, 3, 4}, 10},
+		{"all negative", []int{-8, -3, -6, -2, -5, -4}, -2},
+		{"zeros and negatives", []int{-1, 0, -2}, 0},
+		{"dip worth crossing", []int{5, -4, 5}, 6},
+		{"dip not worth crossing", []int{3, -4, 2}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MaxSubarraySum(tt.arr); got != tt.want {
+				t.Errorf("MaxSubarraySum(%v) = %d, want %d", tt.arr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaxSubarray(t *testing.T) {
+	tests := []struct {
+		name      string
+		arr       []int
+		wantSum   int
+		wantStart int
+		wantEnd   int
+		wantOK    bool
+	}{
+		{"empty array", []int{}, 0, 0, 0, false},
+		{"single element", []int{-7}, -7, 0, 1, true},
+		{"classic example", []int{-2, 1, -3, 4, -1, 2, 1, -5, 4}, 6, 3, 7, true},
+		{"all negative", []int{-8, -3, -6, -2, -5, -4}, -2, 3, 4, true},
+		{"best at the end", []int{-1, -2, 3, 4}, 7, 2, 4, true},
+		{"tie returns first to end", []int{3, -5, 3}, 3, 0, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSum, gotStart, gotEnd, gotOK := MaxSubarray(tt.arr)
+			if gotSum != tt.wantSum || gotStart != tt.wantStart || gotEnd != tt.wantEnd || gotOK != tt.wantOK {
+				t.Errorf("MaxSubarray(%v) = (%d, %d, %d, %t), want (%d, %d, %d, %t)",
+					tt.arr, gotSum, gotStart, gotEnd, gotOK, tt.wantSum, tt.wantStart, tt.wantEnd, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMaxSubarrayMatchesBruteForce(t *testing.T) {
+	for _, size := range []int{1, 2, 10, 100, 1000} {
+		t.Run(fmt.Sprintf("size_%d", size), func(t *testing.T) {
+			// Values in [-50, 50] so subarrays both gain and lose.
+			arr := make([]int, size)
+			for i := range arr {
+				arr[i] = testIntVals[i]%101 - 50
+			}
+
+			want := bruteForceMaxSubarray(arr)
+
+			sum, start, end, ok := MaxSubarray(arr)
+			if !ok || sum != want {
+				t.Fatalf("MaxSubarray() sum = %d, ok = %t, want %d, true", sum, ok, want)
+			}
+
+			// The indexes must describe a subarray that really has that sum.
+			got := 0
+			for _, v := range arr[start:end] {
+				got += v
+			}
+
+			if got != sum {
+				t.Errorf("arr[%d:%d] sums to %d, but MaxSubarray() reported %d", start, end, got, sum)
+			}
+		})
+	}
+}
+
+func BenchmarkMaxSubarraySum(b *testing.B) {
+	sizes := []int{100, 1000, 10000, 100000}
+
+	for _, size := range sizes {
+		arr := make([]int, size)
+		for i := range arr {
+			arr[i] = testIntVals[i]%101 - 50
+		}
+
+		b.Run(fmt.Sprintf("size_%d", size), func(b *testing.B) {
+			for b.Loop() {
+				MaxSubarraySum(arr)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+import "sync"
+
+// parallelCutoff is the slice length below which ParallelDivideConquer stops
+// splitting and scans sequentially. Below a few thousand elements the cost
+// of starting a goroutine outweighs the scan it would take over.
+const parallelCutoff = 4096
+
+// ParallelDivideConquer returns the maximum value in arr, or 0 if arr is
+// empty, by splitting the slice in half, finding the maximum of each half in
+// its own goroutine, and keeping the larger of the two.
+//
+// Running in parallel does not change the total work: every element is still
+// compared once, so the algorithm is O(n) like FindMaximum. What changes is
+// the span, the longest chain of steps that must happen one after another.
+// With the recursion tree O(log n) levels deep and sequential scans of at
+// most parallelCutoff elements at the leaves, the span is O(log n), and on p
+// cores the running time is O(n/p + log n). The benchmarks measure elapsed
+// time on a fixed number of cores, so they see the O(n) work.
+func ParallelDivideConquer(arr []int) int {
+	if len(arr) == 0 {
+		return 0
+	}
+
+	if len(arr) <= parallelCutoff {
+		maxVal, _ := FindMaximum(arr)
+
+		return maxVal
+	}
+
+	mid := len(arr) / 2
+
+	var wg sync.WaitGroup
+	var left int
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		left = ParallelDivideConquer(arr[:mid])
+	}()
+
+	// Handle the right half on this goroutine rather than starting another.
+	right := ParallelDivideConquer(arr[mid:])
+	wg.Wait()
+
+	return max(left, right)
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+const (
+	// radixBits is the width of the digit each RadixSort pass sorts on.
+	radixBits = 8
+
+	// radixBuckets is the number of distinct digit values per pass.
+	radixBuckets = 1 << radixBits
+
+	// radixPasses is the number of passes needed to cover a 64-bit word.
+	radixPasses = 64 / radixBits
+)
+
+// RadixSort sorts integers with a least significant digit (LSD) radix sort
+// and returns a sorted copy, leaving the input untouched.
+//
+// Each value is treated as a fixed 64-bit word split into radixPasses digits
+// of radixBits bits. Every pass is a stable counting sort on one digit,
+// starting from the lowest, so after the last pass the values are ordered by
+// the whole word. A pass costs O(n + radixBuckets), and the number of passes
+// is fixed by the word width rather than by n or by the values, so the total
+// is O(8 * (n + 256)) = O(n). Negative values are handled by flipping the
+// sign bit, which maps the int range onto the uint64 range in order.
+//
+// Compare linearithmic.RadixSortOptimization, which is O(n log n) because it
+// adds O(log n) work per element in every pass.
+func RadixSort(arr []int) []int {
+	src := make([]uint64, len(arr))
+	for i, v := range arr {
+		src[i] = uint64(v) ^ (1 << 63)
+	}
+
+	dst := make([]uint64, len(arr))
+
+	for pass := range radixPasses {
+		shift := pass * radixBits
+
+		// Count the occurrences of each digit - O(n)
+		var counts [radixBuckets]int
+		for _, v := range src {
+			counts[(v>>shift)&(radixBuckets-1)]++
+		}
+
+		// Every value has the same digit, so this pass would not move anything
+		if len(src) == 0 || counts[(src[0]>>shift)&(radixBuckets-1)] == len(src) {
+			continue
+		}
+
+		// Turn the counts into the starting position of each digit - O(k)
+		pos := 0
+		for d, count := range counts {
+			counts[d] = pos
+			pos += count
+		}
+
+		// Place the values in digit order, keeping the order of equal digits
+		// from the previous pass - O(n)
+		for _, v := range src {
+			d := (v >> shift) & (radixBuckets - 1)
+			dst[counts[d]] = v
+			counts[d]++
+		}
+
+		src, dst = dst, src
+	}
+
+	result := make([]int, len(arr))
+	for i, v := range src {
+		result[i] = int(v ^ (1 << 63))
+	}
+
+	return result
+}
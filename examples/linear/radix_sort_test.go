@@ -0,0 +1,103 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRadixSort(t *testing.T) {
+	tests := []struct {
+		name string
+		arr  []int
+	}{
+		{"empty array", []int{}},
+		{"nil array", nil},
+		{"single element", []int{5}},
+		{"already sorted", []int{1, 2, 3, 4, 5}},
+		{"reverse sorted", []int{5, 4, 3, 2, 1}},
+		{"duplicates", []int{3, 1, 3, 1, 3, 2}},
+		{"negative numbers", []int{-1, -5, 0, 3, -2}},
+		{"all equal", []int{7, 7, 7, 7}},
+		{"digit boundaries", []int{256, 255, 65536, 65535, 1, 0, -256, -255}},
+		{"int extremes", []int{math.MaxInt, 0, math.MinInt, -1, 1, math.MinInt + 1, math.MaxInt - 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := make([]int, len(tt.arr))
+			copy(want, tt.arr)
+			sort.Ints(want)
+
+			if got := RadixSort(tt.arr); !cmp.Equal(got, want) {
+				t.Errorf("RadixSort(%v) = %v, want %v", tt.arr, got, want)
+			}
+		})
+	}
+}
+
+func TestRadixSortMatchesSortInts(t *testing.T) {
+	for _, size := range []int{10, 100, 10000} {
+		t.Run(fmt.Sprintf("size_%d", size), func(t *testing.T) {
+			// Spread the values over the whole int range so every pass has
+			// work to do, with half of them negative.
+			arr := make([]int, size)
+			for i := range arr {
+				arr[i] = testIntVals[i] * 0x5DEECE66D1234567
+			}
+
+			want := make([]int, len(arr))
+			copy(want, arr)
+			sort.Ints(want)
+
+			if got := RadixSort(arr); !cmp.Equal(got, want) {
+				t.Errorf("RadixSort() of size %d does not match sort.Ints", size)
+			}
+		})
+	}
+}
+
+func TestRadixSortDoesNotModifyOriginal(t *testing.T) {
+	original := []int{3, -1, 2}
+	originalCopy := []int{3, -1, 2}
+
+	RadixSort(original)
+
+	if !cmp.Equal(original, originalCopy) {
+		t.Errorf("RadixSort modified original array: got %v, want %v", original, originalCopy)
+	}
+}
+
+func BenchmarkRadixSort(b *testing.B) {
+	sizes := []int{1000, 10000, 100000, 1000000}
+
+	for _, size := range sizes {
+		arr := make([]int, size)
+		for i := range arr {
+			arr[i] = testIntVals[i]
+		}
+
+		b.Run(fmt.Sprintf("size_%d", size), func(b *testing.B) {
+			for b.Loop() {
+				RadixSort(arr)
+			}
+		})
+	}
+}
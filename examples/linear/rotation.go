@@ -0,0 +1,54 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+// RotateLeft rotates arr left by k positions in place and returns it, so the
+// element at index k moves to index 0. A negative k rotates right, and k
+// larger than the length wraps around.
+//
+// This uses the reversal algorithm: reverse the first k elements, reverse the
+// rest, then reverse the whole slice. Each element is swapped a constant
+// number of times, so it is O(n) time with O(1) extra space, compared to the
+// O(k) scratch buffer a copy-based rotation needs.
+func RotateLeft(arr []int, k int) []int {
+	n := len(arr)
+	if n == 0 {
+		return arr
+	}
+
+	// Normalize k into [0, n) so negative and oversized shifts work.
+	k %= n
+	if k < 0 {
+		k += n
+	}
+
+	if k == 0 {
+		return arr
+	}
+
+	ReverseInPlace(arr[:k])
+	ReverseInPlace(arr[k:])
+	ReverseInPlace(arr)
+
+	return arr
+}
+
+// ReverseInPlace reverses arr in place by swapping pairs from both ends
+// toward the middle. That is n/2 swaps, so it is O(n).
+func ReverseInPlace(arr []int) {
+	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
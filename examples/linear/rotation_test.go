@@ -0,0 +1,128 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linear
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRotateLeft(t *testing.T) {
+	tests := []struct {
+		name string
+		arr  []int
+		k    int
+		want []int
+	}{
+		{"empty slice", []int{}, 3, []int{}},
+		{"nil slice", nil, 1, nil},
+		{"single element", []int{7}, 5, []int{7}},
+		{"k zero", []int{1, 2, 3, 4, 5}, 0, []int{1, 2, 3, 4, 5}},
+		{"k one", []int{1, 2, 3, 4, 5}, 1, []int{2, 3, 4, 5, 1}},
+		{"k in middle", []int{1, 2, 3, 4, 5}, 2, []int{3, 4, 5, 1, 2}},
+		{"k equals len", []int{1, 2, 3, 4, 5}, 5, []int{1, 2, 3, 4, 5}},
+		{"k greater than len", []int{1, 2, 3, 4, 5}, 7, []int{3, 4, 5, 1, 2}},
+		{"k many times len", []int{1, 2, 3}, 301, []int{2, 3, 1}},
+		{"k negative", []int{1, 2, 3, 4, 5}, -1, []int{5, 1, 2, 3, 4}},
+		{"k negative greater than len", []int{1, 2, 3, 4, 5}, -7, []int{4, 5, 1, 2, 3}},
+		{"duplicates", []int{1, 1, 2, 2}, 1, []int{1, 2, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arr := make([]int, len(tt.arr))
+			copy(arr, tt.arr)
+			if tt.arr == nil {
+				arr = nil
+			}
+
+			got := RotateLeft(arr, tt.k)
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("RotateLeft(%v, %d) = %v, want %v", tt.arr, tt.k, got, tt.want)
+			}
+
+			// The rotation happens in place.
+			if !cmp.Equal(arr, tt.want) {
+				t.Errorf("RotateLeft(%v, %d) left the input as %v, want %v", tt.arr, tt.k, arr, tt.want)
+			}
+		})
+	}
+}
+
+func TestRotateLeftRoundTrip(t *testing.T) {
+	for _, n := range []int{2, 17, 100} {
+		for _, k := range []int{1, n / 2, n - 1, 3 * n} {
+			arr := make([]int, n)
+			for i := range arr {
+				arr[i] = i
+			}
+
+			RotateLeft(arr, k)
+			RotateLeft(arr, -k)
+
+			for i, v := range arr {
+				if v != i {
+					t.Fatalf("RotateLeft(RotateLeft(arr, %d), %d) with n=%d: arr[%d] = %d, want %d", k, -k, n, i, v, i)
+				}
+			}
+		}
+	}
+}
+
+func TestReverseInPlace(t *testing.T) {
+	tests := []struct {
+		name string
+		arr  []int
+		want []int
+	}{
+		{"empty slice", []int{}, []int{}},
+		{"single element", []int{1}, []int{1}},
+		{"two elements", []int{1, 2}, []int{2, 1}},
+		{"odd length", []int{1, 2, 3, 4, 5}, []int{5, 4, 3, 2, 1}},
+		{"even length", []int{1, 2, 3, 4}, []int{4, 3, 2, 1}},
+		{"negative numbers", []int{-3, 0, 3}, []int{3, 0, -3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arr := make([]int, len(tt.arr))
+			copy(arr, tt.arr)
+
+			ReverseInPlace(arr)
+			if !cmp.Equal(arr, tt.want) {
+				t.Errorf("ReverseInPlace(%v) = %v, want %v", tt.arr, arr, tt.want)
+			}
+		})
+	}
+}
+
+// BenchmarkRotateLeft rotates by half the length, so every element is
+// swapped twice by the three reversals.
+func BenchmarkRotateLeft(b *testing.B) {
+	for _, n := range []int{1000, 10000, 100000} {
+		arr := make([]int, n)
+		for i := range arr {
+			arr[i] = i
+		}
+
+		b.Run(fmt.Sprintf("RotateLeft-%d", n), func(b *testing.B) {
+			for b.Loop() {
+				_ = RotateLeft(arr, n/2)
+			}
+		})
+	}
+}
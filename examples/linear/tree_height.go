@@ -16,14 +16,35 @@ package linear
 
 import "github.com/rsned/bigo/examples/datatypes/tree"
 
+// TreeHeight performs O(n) height calculation for a BST.
+// This demonstrates linear time complexity because the height can only be
+// known after visiting every node in the tree, regardless of whether the tree
+// is balanced (height ≈ log n) or degenerate (height = n).
+// The height of an empty tree is 0.
+func TreeHeight(root *tree.BSTNode) int {
+	return treeHeightVisit(root, nil)
+}
+
 // FindTreeHeight performs O(n) height calculation for a BST.
+//
+// Deprecated: Use TreeHeight instead.
 func FindTreeHeight(root *tree.BSTNode) int {
+	return TreeHeight(root)
+}
+
+// treeHeightVisit recursively computes the height of the tree, calling
+// visit (if non-nil) once for every node it examines.
+func treeHeightVisit(root *tree.BSTNode, visit func(*tree.BSTNode)) int {
 	if root == nil {
 		return 0
 	}
 
-	leftHeight := FindTreeHeight(root.Left)
-	rightHeight := FindTreeHeight(root.Right)
+	if visit != nil {
+		visit(root)
+	}
+
+	leftHeight := treeHeightVisit(root.Left, visit)
+	rightHeight := treeHeightVisit(root.Right, visit)
 
 	if leftHeight > rightHeight {
 		return leftHeight + 1
@@ -62,6 +62,65 @@ func TestFindTreeHeightUnbalanced(t *testing.T) {
 	}
 }
 
+func TestTreeHeight(t *testing.T) {
+	const size = 63
+
+	values := make([]int, size)
+	for i := range size {
+		values[i] = i + 1
+	}
+
+	// BuildBST on sorted values produces a balanced tree.
+	balanced := tree.BuildBST(values)
+
+	// Sequential InsertBST of sorted values degenerates into a linked list.
+	var degenerate *tree.BSTNode
+	for _, v := range values {
+		degenerate = degenerate.InsertBST(v)
+	}
+
+	tests := []struct {
+		name string
+		root *tree.BSTNode
+		want int
+	}{
+		{"nil tree", nil, 0},
+		{"balanced tree", balanced, 6},
+		{"degenerate tree", degenerate, size},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TreeHeight(tt.root)
+			if got != tt.want {
+				t.Errorf("TreeHeight() = %d, want %d", got, tt.want)
+			}
+
+			if old := FindTreeHeight(tt.root); old != got {
+				t.Errorf("FindTreeHeight() = %d, want it to match TreeHeight() = %d", old, got)
+			}
+		})
+	}
+}
+
+func TestTreeHeightVisitsEveryNode(t *testing.T) {
+	for _, size := range []int{1, 10, 100, 1000} {
+		values := make([]int, size)
+		for i := range size {
+			values[i] = i + 1
+		}
+
+		root := tree.BuildBST(values)
+
+		visited := 0
+		_ = treeHeightVisit(root, func(_ *tree.BSTNode) { visited++ })
+
+		if visited != size {
+			t.Errorf("treeHeightVisit() on %d nodes visited %d nodes, want %d", size, visited, size)
+		}
+	}
+}
+
 func TestIsBalanced(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -92,6 +92,12 @@ func (uf *BoruvkaUnionFind) ComponentCount() int {
 }
 
 // BoruvkaMST implements Borůvka's minimum spanning tree algorithm
+// Each round scans every edge to find the cheapest edge leaving each
+// component, then adds all of those edges at once, contracting each
+// component into at least one neighbour. The number of components at least
+// halves every round, so there are O(log n) rounds of O(m) work.
+// On a disconnected graph it returns a minimum spanning forest, as
+// KruskalMST does.
 // Time complexity: O(m log n) where m is edges and n is vertices
 // Space complexity: O(n + m)
 func BoruvkaMST(graph *BoruvkaGraph) ([]BoruvkaEdge, float64) {
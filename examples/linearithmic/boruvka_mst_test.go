@@ -17,7 +17,10 @@ package linearithmic
 import (
 	"fmt"
 	"math"
+	"math/rand/v2"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestBoruvkaMST(t *testing.T) {
@@ -295,6 +298,86 @@ func TestCompareMSTAlgorithms(t *testing.T) {
 	}
 }
 
+// randomBoruvkaGraph returns a connected graph on n vertices: a random
+// spanning tree plus extra random edges. Weights come from a small range so
+// that many edges tie, which is where Borůvka's per-component choices are
+// easiest to get wrong.
+func randomBoruvkaGraph(r *rand.Rand, n, extra int) *BoruvkaGraph {
+	graph := &BoruvkaGraph{
+		Vertices: n,
+		Edges:    make([]BoruvkaEdge, 0, n-1+extra),
+	}
+
+	for v := 1; v < n; v++ {
+		graph.Edges = append(graph.Edges, BoruvkaEdge{U: r.IntN(v), V: v, Weight: float64(1 + r.IntN(3))})
+	}
+
+	for range extra {
+		u, v := r.IntN(n), r.IntN(n)
+		if u != v {
+			graph.Edges = append(graph.Edges, BoruvkaEdge{U: u, V: v, Weight: float64(1 + r.IntN(3))})
+		}
+	}
+
+	return graph
+}
+
+func TestBoruvkaMSTMatchesKruskal(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	tests := []struct {
+		name  string
+		graph *BoruvkaGraph
+	}{
+		{"Kruskal sample graph", ConvertKruskalToBoruvka(BuildKruskalSampleGraph())},
+		{"Borůvka sample graph", BuildBoruvkaSampleGraph()},
+		{"Complete graph 30", BuildCompleteGraph(30)},
+		{"All weights equal", &BoruvkaGraph{
+			Vertices: 5,
+			Edges: []BoruvkaEdge{
+				{0, 1, 1.0}, {1, 2, 1.0}, {2, 0, 1.0},
+				{2, 3, 1.0}, {3, 4, 1.0}, {4, 2, 1.0},
+			},
+		}},
+		{"Disconnected graph", &BoruvkaGraph{
+			Vertices: 6,
+			Edges: []BoruvkaEdge{
+				{0, 1, 3.0}, {1, 2, 1.0}, {0, 2, 2.0},
+				{3, 4, 5.0}, {4, 5, 4.0}, {3, 5, 6.0},
+			},
+		}},
+	}
+
+	for i := range 20 {
+		tests = append(tests, struct {
+			name  string
+			graph *BoruvkaGraph
+		}{fmt.Sprintf("Random graph %d", i), randomBoruvkaGraph(r, 2+r.IntN(40), r.IntN(80))})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boruvkaMST, boruvkaWeight := BoruvkaMST(tt.graph)
+
+			kruskalGraph := ConvertBoruvkaToKruskal(tt.graph)
+			kruskalMST, kruskalWeight := KruskalMST(kruskalGraph)
+
+			if math.Abs(boruvkaWeight-kruskalWeight) > 1e-9 {
+				t.Errorf("BoruvkaMST weight = %.2f, KruskalMST weight = %.2f", boruvkaWeight, kruskalWeight)
+			}
+
+			if len(boruvkaMST) != len(kruskalMST) {
+				t.Errorf("BoruvkaMST has %d edges, KruskalMST has %d", len(boruvkaMST), len(kruskalMST))
+			}
+
+			// The round trip through the Kruskal types must not change the graph.
+			if back := ConvertKruskalToBoruvka(kruskalGraph); !cmp.Equal(back, tt.graph) {
+				t.Errorf("ConvertKruskalToBoruvka(ConvertBoruvkaToKruskal(g)) = %v, want %v", back, tt.graph)
+			}
+		})
+	}
+}
+
 func TestBuildCompleteGraph(t *testing.T) {
 	sizes := []int{3, 4, 5, 10}
 
@@ -416,4 +499,12 @@ func BenchmarkBoruvkaVsKruskal(b *testing.B) {
 			KruskalMST(kruskalGraph)
 		}
 	})
+
+	b.Run("Prim", func(b *testing.B) {
+		kruskalGraph := ConvertBoruvkaToKruskal(graph)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			PrimMST(kruskalGraph, 0)
+		}
+	})
 }
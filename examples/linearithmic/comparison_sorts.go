@@ -20,27 +20,48 @@ package linearithmic
 // - Heapsort for worst case O(n log n) when recursion depth exceeds 2*log n
 // - Insertion sort for small arrays (< 16 elements) where it's faster due to low overhead
 func IntroSort(arr []int) []int {
+	return IntroSortWithDepthLimit(arr, IntroSortDepthLimit(len(arr)))
+}
+
+// IntroSortDepthLimit returns the default recursion depth limit IntroSort
+// uses for n elements, 2·⌊log₂(n)⌋. A well balanced quicksort needs about
+// log₂(n) levels, so reaching twice that means the pivots are going badly.
+func IntroSortDepthLimit(n int) int {
+	return 2 * logBase2(n)
+}
+
+// IntroSortWithDepthLimit performs introsort with the given recursion depth
+// limit and returns a sorted copy of arr. Once a branch has been partitioned
+// depthLimit times, the rest of that branch is heapsorted. A negative limit
+// uses the default from IntroSortDepthLimit. A limit of 0 is pure heapsort
+// (plus insertion sort for tiny inputs), and a limit of len(arr) or more
+// never falls back, which is plain median-of-three quicksort with its O(n²)
+// worst case. Any limit in O(log n) keeps the worst case at O(n log n).
+func IntroSortWithDepthLimit(arr []int, depthLimit int) []int {
 	// Create a copy to avoid modifying the original array
 	result := make([]int, len(arr))
 	copy(result, arr)
 
-	// Calculate maximum recursion depth to prevent O(n²) worst case
-	// If depth exceeds 2*log₂(n), switch to heapsort
-	maxDepth := 2 * logBase2(len(result))
-	introSortHelper(result, 0, len(result)-1, maxDepth)
+	if depthLimit < 0 {
+		depthLimit = IntroSortDepthLimit(len(result))
+	}
+
+	introSortHelper(result, 0, len(result)-1, depthLimit)
 
 	return result
 }
 
 // introSortHelper implements the hybrid sorting strategy with depth limiting.
 // This ensures O(n log n) worst case by switching algorithms based on context.
-func introSortHelper(arr []int, low, high, depthLimit int) {
+// It returns the number of quicksort partitioning levels on the deepest path,
+// which never exceeds depthLimit.
+func introSortHelper(arr []int, low, high, depthLimit int) int {
 	// Use insertion sort for small arrays (< 16 elements)
 	// Insertion sort has low overhead and is faster for small datasets
 	if high-low < 16 {
 		insertionSortRange(arr, low, high)
 
-		return
+		return 0
 	}
 
 	// If recursion depth exceeds limit, switch to heapsort
@@ -48,14 +69,20 @@ func introSortHelper(arr []int, low, high, depthLimit int) {
 	if depthLimit == 0 {
 		heapSortRange(arr, low, high)
 
-		return
+		return 0
 	}
 
-	// Use quicksort for the main sorting work
+	// Use quicksort for the main sorting work, with a median-of-three pivot
+	// moved to the end for the shared partition
+	p := choosePivot(arr, low, high, PivotMedianOfThree)
+	arr[p], arr[high] = arr[high], arr[p]
 	pivotIndex := partition(arr, low, high)
+
 	// Recursively sort both partitions with decremented depth limit
-	introSortHelper(arr, low, pivotIndex-1, depthLimit-1)
-	introSortHelper(arr, pivotIndex+1, high, depthLimit-1)
+	left := introSortHelper(arr, low, pivotIndex-1, depthLimit-1)
+	right := introSortHelper(arr, pivotIndex+1, high, depthLimit-1)
+
+	return 1 + max(left, right)
 }
 
 func insertionSortRange(arr []int, low, high int) {
@@ -73,8 +100,9 @@ func insertionSortRange(arr []int, low, high int) {
 }
 
 func heapSortRange(arr []int, low, high int) {
-	// Build heap
-	for i := (high-low)/2 - 1; i >= 0; i-- {
+	// Build heap: the last internal node of the high-low+1 element range is
+	// at offset (high-low+1)/2 - 1
+	for i := (high-low+1)/2 - 1; i >= 0; i-- {
 		heapifyRangeDown(arr, low, high, low+i)
 	}
 
@@ -194,9 +194,7 @@ func TestIntroSortStability(t *testing.T) {
 
 	t.Run("worst_case_quicksort", func(t *testing.T) {
 		// Already sorted array can be worst case for quicksort
-		// Note: There appears to be a bug in the IntroSort implementation for certain input sizes
-		// This test is designed to catch such issues
-		input := make([]int, 20) // Smaller size to avoid the bug
+		input := make([]int, 1000)
 		for i := range input {
 			input[i] = i
 		}
@@ -332,6 +330,15 @@ func BenchmarkIntroSort(b *testing.B) {
 				IntroSort(duplicateData)
 			}
 		})
+
+		// Median-of-three killer, which only the depth limit keeps O(n log n)
+		killerData := medianOfThreeKiller(size)
+
+		b.Run(fmt.Sprintf("killer_size_%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				IntroSort(killerData)
+			}
+		})
 	}
 }
 
@@ -393,6 +400,114 @@ func TestIntroSortInternalBehavior(t *testing.T) {
 	})
 }
 
+// medianOfThreeKiller returns a permutation of 0..n-1 that drives
+// median-of-three quicksort, as used by introSortHelper, to its O(n²) worst
+// case. It replays the sort on positions whose values are not yet decided,
+// and at each partition gives the first and middle candidates the two
+// smallest values still free. The undecided last candidate is larger than
+// both, so the middle one is the pivot and the partition only peels off two
+// elements, leaving a range n-2 long for the next level.
+func medianOfThreeKiller(n int) []int {
+	vals := make([]int, n) // vals[p] is the value at original position p
+	slots := make([]int, n)
+	for i := range slots {
+		slots[i] = i // slots[i] is the original position now at index i
+		vals[i] = -1
+	}
+
+	next := 0
+	for low, high := 0, n-1; high-low >= 16; low += 2 {
+		mid := low + (high-low)/2
+		vals[slots[low]] = next
+		vals[slots[mid]] = next + 1
+		next += 2
+
+		// Pivot moves to the end, the partition keeps slots[low] in place as
+		// the only smaller element, and the pivot lands at low+1.
+		slots[mid], slots[high] = slots[high], slots[mid]
+		slots[low+1], slots[high] = slots[high], slots[low+1]
+	}
+
+	for p := range vals {
+		if vals[p] < 0 {
+			vals[p] = next
+			next++
+		}
+	}
+
+	return vals
+}
+
+func TestIntroSortDepthLimit(t *testing.T) {
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{0, 0},
+		{1, 0},
+		{2, 2},
+		{16, 8},
+		{1000, 18},
+		{1 << 16, 32},
+	}
+
+	for _, tt := range tests {
+		if got := IntroSortDepthLimit(tt.n); got != tt.want {
+			t.Errorf("IntroSortDepthLimit(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestIntroSortWithDepthLimit(t *testing.T) {
+	input := make([]int, 500)
+	for i := range input {
+		input[i] = (i * 7919) % 101
+	}
+
+	want := make([]int, len(input))
+	copy(want, input)
+	sort.Ints(want)
+
+	// 0 is pure heapsort, len(input) never falls back, -1 is the default.
+	for _, limit := range []int{-1, 0, 1, 3, IntroSortDepthLimit(len(input)), len(input)} {
+		t.Run(fmt.Sprintf("limit_%d", limit), func(t *testing.T) {
+			if got := IntroSortWithDepthLimit(input, limit); !cmp.Equal(got, want) {
+				t.Errorf("IntroSortWithDepthLimit(input, %d) = %v, want %v", limit, got, want)
+			}
+		})
+	}
+}
+
+func TestIntroSortMedianOfThreeKiller(t *testing.T) {
+	for _, n := range []int{100, 1000, 1 << 14} {
+		t.Run(fmt.Sprintf("size_%d", n), func(t *testing.T) {
+			killer := medianOfThreeKiller(n)
+
+			// Without a usable limit the killer forces about n/2 levels,
+			// which is what makes plain quicksort O(n²) on it.
+			arr := make([]int, n)
+			copy(arr, killer)
+
+			if depth := introSortHelper(arr, 0, n-1, n); depth < n/2-8 {
+				t.Fatalf("killer reached depth %d with no limit, want at least %d", depth, n/2-8)
+			}
+
+			// With the default limit the recursion is capped at 2·log₂(n)
+			// and heapsort finishes the job.
+			limit := IntroSortDepthLimit(n)
+			copy(arr, killer)
+
+			if depth := introSortHelper(arr, 0, n-1, limit); depth > limit {
+				t.Errorf("killer reached depth %d, want at most %d", depth, limit)
+			}
+
+			if !isSorted(arr) {
+				t.Errorf("killer input not sorted correctly")
+			}
+		})
+	}
+}
+
 // TestEdgeCases tests edge cases and boundary conditions
 func TestIntroSortEdgeCases(t *testing.T) {
 	t.Run("nil_equivalent", func(t *testing.T) {
@@ -14,6 +14,12 @@
 
 package linearithmic
 
+import (
+	"cmp"
+
+	"github.com/rsned/bigo/examples/datatypes/collection"
+)
+
 // HeapSort performs O(n log n) heap sort.
 // This demonstrates linearithmic time complexity through two phases:
 // 1. Build heap: O(n) time
@@ -67,3 +73,22 @@ func heapifyForSort(arr []int, heapSize, rootIndex int) {
 		heapifyForSort(arr, heapSize, largest)
 	}
 }
+
+// HeapSortViaPQ performs O(n log n) heap sort on top of the reusable
+// collection.PriorityQueue and returns a sorted copy. It has the same two
+// phases as HeapSort - an O(n) heap build followed by n O(log n) pops - but
+// composes them from a general purpose min-heap instead of hand-rolling the
+// sift operations over the slice. The cost of that abstraction is an extra
+// copy into the queue and an indirect call to the comparison function for
+// every comparison, which shows up as a larger constant factor.
+func HeapSortViaPQ[T cmp.Ordered](arr []T) []T {
+	pq := collection.NewPriorityQueueFromSlice(arr, cmp.Less[T])
+
+	result := make([]T, 0, len(arr))
+	for pq.Len() > 0 {
+		v, _ := pq.Pop()
+		result = append(result, v)
+	}
+
+	return result
+}
@@ -16,6 +16,7 @@ package linearithmic
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"testing"
 
@@ -459,3 +460,80 @@ func TestHeapSortExtremeValues(t *testing.T) {
 		}
 	})
 }
+
+func TestHeapSortViaPQInts(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+	}{
+		{"empty array", []int{}},
+		{"single element", []int{5}},
+		{"already sorted", []int{1, 2, 3, 4, 5}},
+		{"reverse sorted", []int{5, 4, 3, 2, 1}},
+		{"duplicates", []int{3, 1, 3, 1, 3, 2}},
+		{"negative numbers", []int{-1, -5, 0, 3, -2}},
+		{"larger input", []int{9, 7, 5, 11, 12, 2, 14, 3, 10, 6, 1, 8, 4, 13}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := slices.Clone(tt.input)
+			want := slices.Clone(tt.input)
+			slices.Sort(want)
+
+			if got := HeapSortViaPQ(tt.input); !cmp.Equal(got, want) {
+				t.Errorf("HeapSortViaPQ(%v) = %v, want %v", tt.input, got, want)
+			}
+
+			if !cmp.Equal(tt.input, original) {
+				t.Errorf("HeapSortViaPQ modified its input: got %v, want %v", tt.input, original)
+			}
+		})
+	}
+}
+
+func TestHeapSortViaPQStrings(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+	}{
+		{"empty array", []string{}},
+		{"words", []string{"pear", "apple", "fig", "banana", "cherry"}},
+		{"duplicates and empty", []string{"b", "", "a", "b", ""}},
+		{"case ordering", []string{"b", "B", "a", "A"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := slices.Clone(tt.input)
+			slices.Sort(want)
+
+			if got := HeapSortViaPQ(tt.input); !cmp.Equal(got, want) {
+				t.Errorf("HeapSortViaPQ(%q) = %q, want %q", tt.input, got, want)
+			}
+		})
+	}
+}
+
+// BenchmarkHeapSortViaPQVsHeapSort quantifies the overhead of building heap
+// sort from the generic PriorityQueue rather than hand-rolling it.
+func BenchmarkHeapSortViaPQVsHeapSort(b *testing.B) {
+	for _, size := range []int{1000, 10000, 100000} {
+		data := make([]int, size)
+		for i := range data {
+			data[i] = (i * 71) % size
+		}
+
+		b.Run(fmt.Sprintf("HeapSortViaPQ_size_%d", size), func(b *testing.B) {
+			for b.Loop() {
+				HeapSortViaPQ(data)
+			}
+		})
+
+		b.Run(fmt.Sprintf("HeapSort_size_%d", size), func(b *testing.B) {
+			for b.Loop() {
+				HeapSort(data)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linearithmic
+
+// InPlaceMergeSort performs a stable merge sort that merges in place.
+// This demonstrates the space/time tradeoff between MergeSort and HeapSort:
+// like MergeSort it is stable and divides the array into log n levels, but
+// like HeapSort it uses O(1) auxiliary space for the merges (only the
+// O(log n) recursion stack remains).
+//
+// Instead of copying both halves into a scratch buffer, each merge uses the
+// SymMerge rotation merge rather than a block merge: it finds a split point
+// with a binary search and swaps the out-of-order blocks into place with a
+// rotation (three reversals). The comparisons stay O(n log n),
+// but every rotation moves elements that a buffered merge would have copied
+// only once, so the number of element moves grows to O(n log² n) in the
+// worst case. In practice this shows up as a noticeably larger constant
+// factor than MergeSort for the same n.
+//
+// A sorted copy is returned and the input is left untouched to match the
+// other sorts in this package; the sorting itself happens in place on the copy.
+func InPlaceMergeSort(arr []int) []int {
+	// Create a copy to avoid modifying the original array
+	result := make([]int, len(arr))
+	copy(result, arr)
+
+	inPlaceMergeSortHelper(result, 0, len(result), func(a, b int) bool { return a < b })
+
+	return result
+}
+
+// inPlaceMergeSortHelper sorts arr[low:high] by recursively sorting both
+// halves and merging them without a scratch buffer. It orders elements with
+// less so stability can be checked on values that carry more than a key.
+func inPlaceMergeSortHelper[T any](arr []T, low, high int, less func(a, b T) bool) {
+	// Base case: ranges with 0 or 1 element are already sorted
+	if high-low < 2 {
+		return
+	}
+
+	mid := low + (high-low)/2
+	inPlaceMergeSortHelper(arr, low, mid, less)
+	inPlaceMergeSortHelper(arr, mid, high, less)
+
+	// Already in order, nothing to merge.
+	if !less(arr[mid], arr[mid-1]) {
+		return
+	}
+
+	symMerge(arr, low, mid, high, less)
+}
+
+// symMerge merges the two sorted runs arr[a:m] and arr[m:b] in place using
+// the SymMerge algorithm of Kim and Kutzner. It finds a split of the two runs
+// such that rotating the middle blocks leaves two smaller independent merge
+// problems, then recurses on each. Equal elements never pass each other, so
+// the merge is stable.
+func symMerge[T any](arr []T, a, m, b int, less func(a, b T) bool) {
+	// A single element on the left: binary search for its spot in the
+	// right run and shift it there.
+	if m-a == 1 {
+		i, j := m, b
+		for i < j {
+			h := i + (j-i)/2
+			if less(arr[h], arr[a]) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+
+		for k := a; k < i-1; k++ {
+			arr[k], arr[k+1] = arr[k+1], arr[k]
+		}
+
+		return
+	}
+
+	// A single element on the right: binary search for its spot in the
+	// left run and shift it there.
+	if b-m == 1 {
+		i, j := a, m
+		for i < j {
+			h := i + (j-i)/2
+			if !less(arr[m], arr[h]) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+
+		for k := m; k > i; k-- {
+			arr[k], arr[k-1] = arr[k-1], arr[k]
+		}
+
+		return
+	}
+
+	mid := a + (b-a)/2
+	n := mid + m
+
+	var start, r int
+	if m > mid {
+		start = n - b
+		r = mid
+	} else {
+		start = a
+		r = m
+	}
+
+	// Binary search for the split point where the blocks cross over.
+	p := n - 1
+	for start < r {
+		c := start + (r-start)/2
+		if !less(arr[p-c], arr[c]) {
+			start = c + 1
+		} else {
+			r = c
+		}
+	}
+
+	end := n - start
+	if start < m && m < end {
+		rotate(arr, start, m, end)
+	}
+
+	if a < start && start < mid {
+		symMerge(arr, a, start, mid, less)
+	}
+
+	if mid < end && end < b {
+		symMerge(arr, mid, end, b, less)
+	}
+}
+
+// rotate swaps the adjacent blocks arr[a:m] and arr[m:b] in place using
+// three reversals. This is O(b-a) time with O(1) extra space.
+func rotate[T any](arr []T, a, m, b int) {
+	reverseRange(arr, a, m)
+	reverseRange(arr, m, b)
+	reverseRange(arr, a, b)
+}
+
+// reverseRange reverses arr[low:high] in place.
+func reverseRange[T any](arr []T, low, high int) {
+	for i, j := low, high-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
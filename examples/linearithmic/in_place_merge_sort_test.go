@@ -0,0 +1,134 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linearithmic
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInPlaceMergeSort(t *testing.T) {
+	tests := []struct {
+		name string
+		arr  []int
+	}{
+		{"empty array", []int{}},
+		{"single element", []int{5}},
+		{"two elements", []int{2, 1}},
+		{"already sorted", []int{1, 2, 3, 4, 5}},
+		{"reverse sorted", []int{5, 4, 3, 2, 1}},
+		{"random order", []int{3, 1, 4, 1, 5, 9, 2, 6}},
+		{"duplicates", []int{3, 1, 3, 1, 3}},
+		{"negative numbers", []int{-1, -5, 0, 3, -2}},
+		{"all equal", []int{7, 7, 7, 7, 7, 7}},
+		{"odd length", []int{9, 8, 7, 1, 2, 3, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := make([]int, len(tt.arr))
+			copy(want, tt.arr)
+			sort.Ints(want)
+
+			got := InPlaceMergeSort(tt.arr)
+			if !cmp.Equal(got, want) {
+				t.Errorf("InPlaceMergeSort(%v) = %v, want %v", tt.arr, got, want)
+			}
+		})
+	}
+}
+
+func TestInPlaceMergeSortMatchesSortInts(t *testing.T) {
+	for _, size := range []int{3, 17, 100, 257, 1000, 4096} {
+		t.Run(fmt.Sprintf("size_%d", size), func(t *testing.T) {
+			// Pseudo-random pattern with plenty of duplicates.
+			arr := make([]int, size)
+			for i := range arr {
+				arr[i] = (i*7919 + 13) % (size/3 + 1)
+			}
+
+			want := make([]int, len(arr))
+			copy(want, arr)
+			sort.Ints(want)
+
+			got := InPlaceMergeSort(arr)
+			if !cmp.Equal(got, want) {
+				t.Errorf("InPlaceMergeSort() of size %d does not match sort.Ints", size)
+			}
+		})
+	}
+}
+
+func TestInPlaceMergeSortDoesNotModifyOriginal(t *testing.T) {
+	original := []int{3, 1, 4, 1, 5}
+	originalCopy := make([]int, len(original))
+	copy(originalCopy, original)
+
+	InPlaceMergeSort(original)
+
+	if !cmp.Equal(original, originalCopy) {
+		t.Errorf("InPlaceMergeSort modified original array: got %v, want %v", original, originalCopy)
+	}
+}
+
+func TestInPlaceMergeSortIsStable(t *testing.T) {
+	for _, size := range []int{2, 3, 7, 64, 100, 1000} {
+		t.Run(fmt.Sprintf("size_%d", size), func(t *testing.T) {
+			// Few distinct keys, so every merge has to move runs of equal
+			// keys past each other without reordering them.
+			records := make([]record, size)
+			for i := range records {
+				records[i] = record{Key: (i * 7919) % 5, Seq: i}
+			}
+
+			want := slices.Clone(records)
+			slices.SortStableFunc(want, func(a, b record) int { return a.Key - b.Key })
+
+			inPlaceMergeSortHelper(records, 0, len(records), byKey)
+			if !cmp.Equal(records, want) {
+				t.Errorf("in-place merge sort of %d records is not stable: got %v, want %v", size, records, want)
+			}
+		})
+	}
+}
+
+// BenchmarkInPlaceMergeSortVsMergeSort contrasts the constant factor of the
+// in-place merge against the buffered merge of MergeSort.
+func BenchmarkInPlaceMergeSortVsMergeSort(b *testing.B) {
+	sizes := []int{100, 1000, 10000}
+
+	for _, size := range sizes {
+		data := make([]int, size)
+		for i := range data {
+			data[i] = (i * 71) % size
+		}
+
+		b.Run(fmt.Sprintf("InPlaceMergeSort_size_%d", size), func(b *testing.B) {
+			for b.Loop() {
+				InPlaceMergeSort(data)
+			}
+		})
+
+		b.Run(fmt.Sprintf("MergeSort_size_%d", size), func(b *testing.B) {
+			for b.Loop() {
+				MergeSort(data)
+			}
+		})
+	}
+}
@@ -18,46 +18,66 @@ package linearithmic
 // This demonstrates linearithmic time complexity because we divide the array
 // into halves (log n levels) and at each level we merge all elements (n work).
 // The divide-and-conquer approach gives us log n levels × n work = O(n log n).
+//
+// A sorted copy is returned and the input is left untouched. The sort runs on
+// that copy using a single scratch buffer of n elements, allocated once and
+// reused by every merge, so the number of allocations stays constant rather
+// than growing with the number of merges.
 func MergeSort(arr []int) []int {
-	// Base case: arrays with 0 or 1 element are already sorted
-	if len(arr) <= 1 {
-		return arr
+	// Create a copy to avoid modifying the original array
+	result := make([]int, len(arr))
+	copy(result, arr)
+
+	scratch := make([]int, len(arr))
+	mergeSortHelper(result, scratch, 0, len(result))
+
+	return result
+}
+
+// mergeSortHelper sorts arr[low:high], using the same range of scratch as
+// temporary space.
+func mergeSortHelper(arr, scratch []int, low, high int) {
+	// Base case: ranges with 0 or 1 element are already sorted
+	if high-low < 2 {
+		return
 	}
 
-	// Divide: split array into two halves
-	mid := len(arr) / 2
+	// Divide: split the range into two halves
+	mid := low + (high-low)/2
 	// Conquer: recursively sort both halves
-	left := MergeSort(arr[:mid])  // Sort left half
-	right := MergeSort(arr[mid:]) // Sort right half
+	mergeSortHelper(arr, scratch, low, mid)  // Sort left half
+	mergeSortHelper(arr, scratch, mid, high) // Sort right half
 
 	// Combine: merge the sorted halves
-	return merge(left, right)
+	merge(arr, scratch, low, mid, high)
 }
 
-// merge combines two sorted arrays into a single sorted array.
-// This operation is O(n) where n is the total number of elements,
-// and it's the key operation that makes merge sort O(n log n).
-func merge(left, right []int) []int {
-	// Pre-allocate result slice with exact capacity for efficiency
-	result := make([]int, 0, len(left)+len(right))
-	i, j := 0, 0 // Indices for left and right arrays
+// merge combines the sorted runs arr[low:mid] and arr[mid:high] into a single
+// sorted run in arr[low:high]. This operation is O(n) where n is the total
+// number of elements, and it's the key operation that makes merge sort
+// O(n log n). Both runs are first copied into scratch so arr can be
+// overwritten from the front.
+func merge(arr, scratch []int, low, mid, high int) {
+	copy(scratch[low:high], arr[low:high])
+
+	i, j := low, mid // Indices into the left and right runs in scratch
+	k := low         // Next position to fill in arr
 
 	// Merge elements in sorted order - O(n) operation
-	for i < len(left) && j < len(right) {
-		// Take smaller element from either left or right
-		if left[i] <= right[j] {
-			result = append(result, left[i])
+	for i < mid && j < high {
+		// Take smaller element from either left or right. Taking from the
+		// left on ties keeps the sort stable.
+		if scratch[i] <= scratch[j] {
+			arr[k] = scratch[i]
 			i++ // Move left pointer
 		} else {
-			result = append(result, right[j])
+			arr[k] = scratch[j]
 			j++ // Move right pointer
 		}
+		k++
 	}
 
-	// Append remaining elements from left array (if any)
-	result = append(result, left[i:]...)
-	// Append remaining elements from right array (if any)
-	result = append(result, right[j:]...)
-
-	return result
+	// Copy remaining elements from the left run (if any). Anything left in
+	// the right run is already in place.
+	copy(arr[k:], scratch[i:mid])
 }
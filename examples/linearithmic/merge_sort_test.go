@@ -94,3 +94,44 @@ func BenchmarkMergeSort(b *testing.B) {
 		})
 	}
 }
+
+func TestMergeSortAllocations(t *testing.T) {
+	// The copy of the input and the scratch buffer are the only allocations,
+	// however many levels of merging the size needs.
+	for _, size := range []int{16, 1024, 65536} {
+		arr := make([]int, size)
+		for i := range arr {
+			arr[i] = size - i
+		}
+
+		allocs := testing.AllocsPerRun(10, func() {
+			MergeSort(arr)
+		})
+
+		if allocs > 2 {
+			t.Errorf("MergeSort() of size %d made %.0f allocations, want at most 2", size, allocs)
+		}
+	}
+}
+
+// BenchmarkMergeSortAllocs reports allocs/op across sizes spanning many
+// merge levels; the count should stay flat rather than growing with log n.
+func BenchmarkMergeSortAllocs(b *testing.B) {
+	for _, size := range []int{1 << 4, 1 << 10, 1 << 16} {
+		arr := make([]int, size)
+		for i := range arr {
+			arr[i] = size - i
+		}
+
+		b.Run(fmt.Sprintf("size_%d", size), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				MergeSort(arr)
+			}
+
+			if allocs := testing.AllocsPerRun(1, func() { MergeSort(arr) }); allocs > 2 {
+				b.Errorf("MergeSort() of size %d made %.0f allocations, want at most 2", size, allocs)
+			}
+		})
+	}
+}
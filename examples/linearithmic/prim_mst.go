@@ -0,0 +1,82 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linearithmic
+
+import "github.com/rsned/bigo/examples/datatypes/collection"
+
+// PrimMST implements Prim's minimum spanning tree algorithm with a binary heap
+// Starting from start, the tree grows one vertex at a time: the heap holds
+// every edge leaving the tree seen so far, and the lightest one whose far end
+// is still outside the tree is added next. Each edge is pushed at most twice
+// and popped at most twice, each at O(log m) = O(log n) cost.
+// On a disconnected graph it returns the minimum spanning tree of the
+// component containing start. An out-of-range start returns an empty tree.
+// Time complexity: O(m log n) where m is edges and n is vertices
+// Space complexity: O(n + m)
+func PrimMST(graph *KruskalGraph, start int) ([]KruskalEdge, float64) {
+	if graph.Vertices <= 1 || start < 0 || start >= graph.Vertices {
+		return []KruskalEdge{}, 0
+	}
+
+	// Adjacency lists of edge indexes, so each vertex's edges can be pushed
+	// when it joins the tree
+	adjacent := make([][]int, graph.Vertices)
+	for i, edge := range graph.Edges {
+		adjacent[edge.U] = append(adjacent[edge.U], i)
+		adjacent[edge.V] = append(adjacent[edge.V], i)
+	}
+
+	inTree := make([]bool, graph.Vertices)
+	pq := collection.NewPriorityQueue(func(a, b int) bool {
+		return graph.Edges[a].Weight < graph.Edges[b].Weight
+	})
+
+	visit := func(v int) {
+		inTree[v] = true
+
+		for _, i := range adjacent[v] {
+			edge := graph.Edges[i]
+			if !inTree[edge.U] || !inTree[edge.V] {
+				pq.Push(i)
+			}
+		}
+	}
+
+	mst := make([]KruskalEdge, 0, graph.Vertices-1)
+	totalWeight := 0.0
+
+	visit(start)
+
+	for pq.Len() > 0 && len(mst) < graph.Vertices-1 {
+		i, _ := pq.Pop()
+		edge := graph.Edges[i]
+
+		// Both ends joined the tree after this edge was pushed
+		if inTree[edge.U] && inTree[edge.V] {
+			continue
+		}
+
+		mst = append(mst, edge)
+		totalWeight += edge.Weight
+
+		if inTree[edge.U] {
+			visit(edge.V)
+		} else {
+			visit(edge.U)
+		}
+	}
+
+	return mst, totalWeight
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linearithmic
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestPrimMST(t *testing.T) {
+	testCases := []struct {
+		name           string
+		graph          *KruskalGraph
+		start          int
+		expectedWeight float64
+		expectedEdges  int
+	}{
+		{
+			name:           "Sample graph",
+			graph:          BuildKruskalSampleGraph(),
+			start:          0,
+			expectedWeight: 13.0,
+			expectedEdges:  5,
+		},
+		{
+			name:           "Sample graph from last vertex",
+			graph:          BuildKruskalSampleGraph(),
+			start:          5,
+			expectedWeight: 13.0,
+			expectedEdges:  5,
+		},
+		{
+			name: "Triangle graph",
+			graph: &KruskalGraph{
+				Vertices: 3,
+				Edges: []KruskalEdge{
+					{0, 1, 1.0},
+					{1, 2, 2.0},
+					{0, 2, 3.0},
+				},
+			},
+			start:          2,
+			expectedWeight: 3.0,
+			expectedEdges:  2,
+		},
+		{
+			name: "Parallel edges and self loop",
+			graph: &KruskalGraph{
+				Vertices: 3,
+				Edges: []KruskalEdge{
+					{0, 1, 4.0},
+					{0, 1, 1.0},
+					{1, 1, 0.5},
+					{1, 2, 2.0},
+				},
+			},
+			start:          0,
+			expectedWeight: 3.0,
+			expectedEdges:  2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mst, totalWeight := PrimMST(tc.graph, tc.start)
+
+			if len(mst) != tc.expectedEdges {
+				t.Errorf("Expected %d edges in MST, got %d", tc.expectedEdges, len(mst))
+			}
+
+			if math.Abs(totalWeight-tc.expectedWeight) > 1e-9 {
+				t.Errorf("Expected total weight %.2f, got %.2f", tc.expectedWeight, totalWeight)
+			}
+
+			if err := ValidateKruskalMST(tc.graph, mst); err != nil {
+				t.Errorf("MST validation failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestPrimMSTMatchesKruskal(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+
+	graphs := []*KruskalGraph{
+		BuildKruskalCompleteGraph(30),
+		ConvertBoruvkaToKruskal(BuildBoruvkaSampleGraph()),
+	}
+	for range 20 {
+		graphs = append(graphs, ConvertBoruvkaToKruskal(randomBoruvkaGraph(r, 2+r.IntN(40), r.IntN(80))))
+	}
+
+	for i, graph := range graphs {
+		t.Run(fmt.Sprintf("Graph_%d", i), func(t *testing.T) {
+			_, kruskalWeight := KruskalMST(graph)
+			_, boruvkaWeight := BoruvkaMST(ConvertKruskalToBoruvka(graph))
+
+			for start := range graph.Vertices {
+				mst, primWeight := PrimMST(graph, start)
+
+				if err := ValidateKruskalMST(graph, mst); err != nil {
+					t.Fatalf("PrimMST(g, %d) is not a spanning tree: %v", start, err)
+				}
+
+				if math.Abs(primWeight-kruskalWeight) > 1e-9 || math.Abs(primWeight-boruvkaWeight) > 1e-9 {
+					t.Fatalf("PrimMST(g, %d) weight = %.2f, KruskalMST = %.2f, BoruvkaMST = %.2f",
+						start, primWeight, kruskalWeight, boruvkaWeight)
+				}
+			}
+		})
+	}
+}
+
+func TestPrimMSTDisconnected(t *testing.T) {
+	// Two components, {0,1,2} with MST weight 3 and {3,4,5} with MST weight 9
+	graph := &KruskalGraph{
+		Vertices: 7,
+		Edges: []KruskalEdge{
+			{0, 1, 3.0},
+			{1, 2, 1.0},
+			{0, 2, 2.0},
+			{3, 4, 5.0},
+			{4, 5, 4.0},
+			{3, 5, 6.0},
+		},
+	}
+
+	testCases := []struct {
+		start          int
+		expectedWeight float64
+		expectedEdges  int
+	}{
+		{0, 3.0, 2},
+		{2, 3.0, 2},
+		{4, 9.0, 2},
+		{6, 0.0, 0}, // isolated vertex
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("Start_%d", tc.start), func(t *testing.T) {
+			mst, totalWeight := PrimMST(graph, tc.start)
+
+			if len(mst) != tc.expectedEdges {
+				t.Errorf("Expected %d edges, got %d", tc.expectedEdges, len(mst))
+			}
+
+			if totalWeight != tc.expectedWeight {
+				t.Errorf("Expected total weight %.2f, got %.2f", tc.expectedWeight, totalWeight)
+			}
+		})
+	}
+}
+
+func TestPrimMSTEdgeCases(t *testing.T) {
+	testCases := []struct {
+		name  string
+		graph *KruskalGraph
+		start int
+	}{
+		{"Empty graph", &KruskalGraph{Vertices: 0, Edges: []KruskalEdge{}}, 0},
+		{"Single vertex", &KruskalGraph{Vertices: 1, Edges: []KruskalEdge{}}, 0},
+		{"Negative start", BuildKruskalSampleGraph(), -1},
+		{"Start past last vertex", BuildKruskalSampleGraph(), 6},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mst, totalWeight := PrimMST(tc.graph, tc.start)
+
+			if len(mst) != 0 || totalWeight != 0 {
+				t.Errorf("Expected empty MST, got %d edges with weight %.2f", len(mst), totalWeight)
+			}
+		})
+	}
+}
+
+func BenchmarkPrimMST(b *testing.B) {
+	sizes := []int{10, 20, 50}
+
+	for _, size := range sizes {
+		graph := BuildKruskalCompleteGraph(size)
+
+		b.Run(fmt.Sprintf("Size_%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				PrimMST(graph, 0)
+			}
+		})
+	}
+}
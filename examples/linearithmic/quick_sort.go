@@ -14,10 +14,17 @@
 
 package linearithmic
 
+import (
+	"fmt"
+	"math/rand/v2"
+)
+
 // QuickSort performs O(n log n) quick sort (average case).
 // This demonstrates linearithmic time complexity through divide-and-conquer:
 // we partition around a pivot (O(n)) and recursively sort two sub-arrays,
 // creating on average log n levels of recursion. Total: O(n log n) average case.
+// It is not stable; use StableMergeSort when equal elements must keep their
+// input order.
 func QuickSort(arr []int) []int {
 	// Create a copy to avoid modifying the original array
 	result := make([]int, len(arr))
@@ -64,3 +71,106 @@ func partition(arr []int, low, high int) int {
 	// Return the partition index
 	return i + 1
 }
+
+// PivotStrategy selects how QuickSortWith picks the pivot for each partition.
+type PivotStrategy int
+
+const (
+	// PivotFirst uses the first element of the range. Already sorted or
+	// reverse sorted input makes every partition maximally lopsided, which
+	// degrades to O(n²).
+	PivotFirst PivotStrategy = iota
+	// PivotLast uses the last element of the range, as QuickSort does. It has
+	// the same O(n²) worst case on sorted input as PivotFirst.
+	PivotLast
+	// PivotMedianOfThree uses the median of the first, middle, and last
+	// elements, which splits sorted and reverse sorted input evenly.
+	PivotMedianOfThree
+	// PivotRandom uses a uniformly random element, making the O(n²) case
+	// vanishingly unlikely for any input.
+	PivotRandom
+	// PivotMiddle uses the middle element of the range, which handles sorted
+	// input well but can still be defeated by crafted input.
+	PivotMiddle
+)
+
+// String returns the name of the strategy.
+func (s PivotStrategy) String() string {
+	switch s {
+	case PivotFirst:
+		return "First"
+	case PivotLast:
+		return "Last"
+	case PivotMedianOfThree:
+		return "MedianOfThree"
+	case PivotRandom:
+		return "Random"
+	case PivotMiddle:
+		return "Middle"
+	default:
+		return fmt.Sprintf("PivotStrategy(%d)", int(s))
+	}
+}
+
+// QuickSortWith performs quick sort using the given pivot strategy and
+// returns a sorted copy of arr. Every strategy is O(n log n) on average, but
+// the worst case is O(n²) when the pivots keep landing at the ends of the
+// range. Comparing strategies on already sorted input shows the difference:
+// PivotFirst and PivotLast degrade to O(n²) while PivotMedianOfThree and
+// PivotRandom stay O(n log n). An unknown strategy is treated as PivotLast.
+func QuickSortWith(arr []int, strategy PivotStrategy) []int {
+	// Create a copy to avoid modifying the original array
+	result := make([]int, len(arr))
+	copy(result, arr)
+
+	quickSortWithHelper(result, 0, len(result)-1, strategy)
+
+	return result
+}
+
+// quickSortWithHelper sorts arr[low:high+1], moving the chosen pivot to the
+// end of the range so the shared partition can be used.
+func quickSortWithHelper(arr []int, low, high int, strategy PivotStrategy) {
+	if low >= high {
+		return
+	}
+
+	p := choosePivot(arr, low, high, strategy)
+	arr[p], arr[high] = arr[high], arr[p]
+
+	pivotIndex := partition(arr, low, high)
+	quickSortWithHelper(arr, low, pivotIndex-1, strategy)
+	quickSortWithHelper(arr, pivotIndex+1, high, strategy)
+}
+
+// choosePivot returns the index in [low, high] of the pivot for strategy.
+func choosePivot(arr []int, low, high int, strategy PivotStrategy) int {
+	mid := low + (high-low)/2
+
+	switch strategy {
+	case PivotFirst:
+		return low
+	case PivotMedianOfThree:
+		// Order the three candidates by value and take the middle one.
+		a, b, c := low, mid, high
+		if arr[a] > arr[b] {
+			a, b = b, a
+		}
+
+		if arr[b] > arr[c] {
+			b = c
+		}
+
+		if arr[a] > arr[b] {
+			b = a
+		}
+
+		return b
+	case PivotRandom:
+		return low + rand.IntN(high-low+1)
+	case PivotMiddle:
+		return mid
+	default:
+		return high
+	}
+}
@@ -501,3 +501,95 @@ func TestQuickSortRecursion(t *testing.T) {
 		}
 	})
 }
+
+var pivotStrategies = []PivotStrategy{PivotFirst, PivotLast, PivotMedianOfThree, PivotRandom, PivotMiddle}
+
+func TestQuickSortWith(t *testing.T) {
+	inputs := []struct {
+		name  string
+		input []int
+	}{
+		{"empty array", []int{}},
+		{"single element", []int{5}},
+		{"two elements reverse", []int{2, 1}},
+		{"already sorted", []int{1, 2, 3, 4, 5, 6, 7, 8}},
+		{"reverse sorted", []int{8, 7, 6, 5, 4, 3, 2, 1}},
+		{"random order", []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3}},
+		{"all equal", []int{7, 7, 7, 7, 7}},
+		{"negative numbers", []int{-1, -5, 0, 3, -2}},
+		{"organ pipe", []int{1, 3, 5, 7, 9, 8, 6, 4, 2}},
+	}
+
+	for _, strategy := range pivotStrategies {
+		for _, tt := range inputs {
+			t.Run(fmt.Sprintf("%s/%s", strategy, tt.name), func(t *testing.T) {
+				original := make([]int, len(tt.input))
+				copy(original, tt.input)
+
+				want := make([]int, len(tt.input))
+				copy(want, tt.input)
+				sort.Ints(want)
+
+				got := QuickSortWith(tt.input, strategy)
+				if !cmp.Equal(got, want) {
+					t.Errorf("QuickSortWith(%v, %s) = %v, want %v", tt.input, strategy, got, want)
+				}
+
+				if !cmp.Equal(tt.input, original) {
+					t.Errorf("QuickSortWith(%v, %s) modified its input", original, strategy)
+				}
+			})
+		}
+	}
+}
+
+func TestQuickSortWithLargeInputs(t *testing.T) {
+	for _, strategy := range append(pivotStrategies, PivotStrategy(99)) {
+		arr := make([]int, 2000)
+		for i := range arr {
+			arr[i] = (i*7919 + 13) % 500
+		}
+
+		got := QuickSortWith(arr, strategy)
+		if !isSortedQuickSort(got) || !containsSameElementsQuickSort(got, arr) {
+			t.Errorf("QuickSortWith(size 2000, %s) did not return a sorted permutation of its input", strategy)
+		}
+	}
+}
+
+func TestPivotStrategyString(t *testing.T) {
+	tests := map[PivotStrategy]string{
+		PivotFirst:         "First",
+		PivotLast:          "Last",
+		PivotMedianOfThree: "MedianOfThree",
+		PivotRandom:        "Random",
+		PivotMiddle:        "Middle",
+		PivotStrategy(42):  "PivotStrategy(42)",
+	}
+
+	for s, want := range tests {
+		if got := s.String(); got != want {
+			t.Errorf("PivotStrategy(%d).String() = %q, want %q", int(s), got, want)
+		}
+	}
+}
+
+// BenchmarkQuickSortWithSortedInput runs every pivot strategy on already
+// sorted input. PivotFirst and PivotLast degrade to O(n²) here while
+// PivotMedianOfThree and PivotRandom stay O(n log n).
+func BenchmarkQuickSortWithSortedInput(b *testing.B) {
+	for _, size := range []int{1000, 4000} {
+		sorted := make([]int, size)
+		for i := range sorted {
+			sorted[i] = i
+		}
+
+		for _, strategy := range pivotStrategies {
+			b.Run(fmt.Sprintf("%s_size_%d", strategy, size), func(b *testing.B) {
+				for b.Loop() {
+					QuickSortWith(sorted, strategy)
+				}
+			})
+		}
+	}
+}
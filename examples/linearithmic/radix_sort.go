@@ -19,6 +19,11 @@ import "math"
 // RadixSortOptimization performs O(n log n) radix sort with comparison overhead
 // This demonstrates linearithmic complexity by adding comparison operations
 // to the standard radix sort algorithm, making it O(n log n) instead of O(n).
+// Despite the name it is not an optimization: the radix sort underneath is
+// linear, and the O(n log n) comes entirely from the log₂(n) extra
+// comparisons done for every element in every pass. The number of passes is
+// also the decimal digit count of the largest value rather than a fixed word
+// width. For plain O(n) radix sort see linear.RadixSort.
 func RadixSortOptimization(arr []int) []int {
 	if len(arr) <= 1 {
 		return arr
@@ -0,0 +1,147 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linearithmic
+
+// This file holds comparator-based versions of the sorts in this package.
+// Each follows the same steps as its []int counterpart, with every
+// comparison made through less, so the complexity is unchanged: less is
+// called O(n log n) times on average for QuickSortFunc and in every case for
+// HeapSortFunc and MergeSortFunc. Like the []int sorts they return a new,
+// sorted slice and leave arr untouched.
+
+// QuickSortFunc performs O(n log n) quick sort (average case) of arr using
+// less to order the elements, and returns a sorted copy. Like QuickSort it
+// pivots on the last element of each range, so already sorted input
+// degrades to O(n²). The sort is not stable.
+func QuickSortFunc[T any](arr []T, less func(a, b T) bool) []T {
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	quickSortFuncHelper(result, 0, len(result)-1, less)
+
+	return result
+}
+
+// quickSortFuncHelper sorts arr[low:high+1] in place.
+func quickSortFuncHelper[T any](arr []T, low, high int, less func(a, b T) bool) {
+	if low < high {
+		pivotIndex := partitionFunc(arr, low, high, less)
+		quickSortFuncHelper(arr, low, pivotIndex-1, less)
+		quickSortFuncHelper(arr, pivotIndex+1, high, less)
+	}
+}
+
+// partitionFunc moves every element not greater than the pivot arr[high]
+// before it and returns the pivot's final index.
+func partitionFunc[T any](arr []T, low, high int, less func(a, b T) bool) int {
+	pivot := arr[high]
+	i := low - 1
+
+	for j := low; j < high; j++ {
+		// arr[j] <= pivot, expressed with less alone.
+		if !less(pivot, arr[j]) {
+			i++
+			arr[i], arr[j] = arr[j], arr[i]
+		}
+	}
+
+	arr[i+1], arr[high] = arr[high], arr[i+1]
+
+	return i + 1
+}
+
+// HeapSortFunc performs O(n log n) heap sort of arr using less to order the
+// elements, and returns a sorted copy. It builds a max heap in O(n) and then
+// moves the maximum to the end n times at O(log n) each. The sort is not
+// stable.
+func HeapSortFunc[T any](arr []T, less func(a, b T) bool) []T {
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	for i := len(result)/2 - 1; i >= 0; i-- {
+		heapifyFunc(result, len(result), i, less)
+	}
+
+	for i := len(result) - 1; i > 0; i-- {
+		result[0], result[i] = result[i], result[0]
+		heapifyFunc(result, i, 0, less)
+	}
+
+	return result
+}
+
+// heapifyFunc sifts arr[rootIndex] down until the first heapSize elements
+// form a max heap again - O(log n).
+func heapifyFunc[T any](arr []T, heapSize, rootIndex int, less func(a, b T) bool) {
+	for {
+		largest := rootIndex
+		leftChild := 2*rootIndex + 1
+		rightChild := 2*rootIndex + 2
+
+		if leftChild < heapSize && less(arr[largest], arr[leftChild]) {
+			largest = leftChild
+		}
+
+		if rightChild < heapSize && less(arr[largest], arr[rightChild]) {
+			largest = rightChild
+		}
+
+		if largest == rootIndex {
+			return
+		}
+
+		arr[rootIndex], arr[largest] = arr[largest], arr[rootIndex]
+		rootIndex = largest
+	}
+}
+
+// MergeSortFunc performs O(n log n) merge sort of arr using less to order the
+// elements, and returns a sorted copy. The sort is stable: elements that
+// compare equal keep their original order.
+func MergeSortFunc[T any](arr []T, less func(a, b T) bool) []T {
+	if len(arr) <= 1 {
+		result := make([]T, len(arr))
+		copy(result, arr)
+
+		return result
+	}
+
+	mid := len(arr) / 2
+	left := MergeSortFunc(arr[:mid], less)
+	right := MergeSortFunc(arr[mid:], less)
+
+	return mergeFunc(left, right, less)
+}
+
+// mergeFunc combines two sorted slices into a new sorted slice, taking from
+// left on ties so equal elements stay in order.
+func mergeFunc[T any](left, right []T, less func(a, b T) bool) []T {
+	result := make([]T, 0, len(left)+len(right))
+	i, j := 0, 0
+
+	for i < len(left) && j < len(right) {
+		if less(right[j], left[i]) {
+			result = append(result, right[j])
+			j++
+		} else {
+			result = append(result, left[i])
+			i++
+		}
+	}
+
+	result = append(result, left[i:]...)
+
+	return append(result, right[j:]...)
+}
@@ -0,0 +1,147 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linearithmic
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// sortFuncs lists the comparator-based sorts so each test covers them all.
+var sortFuncs = []struct {
+	name   string
+	stable bool
+	sort   func(arr []employee, less func(a, b employee) bool) []employee
+}{
+	{"QuickSortFunc", false, QuickSortFunc[employee]},
+	{"HeapSortFunc", false, HeapSortFunc[employee]},
+	{"MergeSortFunc", true, MergeSortFunc[employee]},
+}
+
+type employee struct {
+	Name string
+	Age  int
+}
+
+func byAge(a, b employee) bool { return a.Age < b.Age }
+
+func TestSortFuncStructsByField(t *testing.T) {
+	employees := []employee{
+		{"Carol", 41},
+		{"Alice", 29},
+		{"Eve", 35},
+		{"Bob", 52},
+		{"Dave", 23},
+	}
+
+	want := []employee{
+		{"Dave", 23},
+		{"Alice", 29},
+		{"Eve", 35},
+		{"Carol", 41},
+		{"Bob", 52},
+	}
+
+	for _, sf := range sortFuncs {
+		t.Run(sf.name, func(t *testing.T) {
+			original := slices.Clone(employees)
+
+			got := sf.sort(employees, byAge)
+			if !cmp.Equal(got, want) {
+				t.Errorf("%s(byAge) = %v, want %v", sf.name, got, want)
+			}
+
+			if !cmp.Equal(employees, original) {
+				t.Errorf("%s modified its input: got %v, want %v", sf.name, employees, original)
+			}
+		})
+	}
+}
+
+func TestSortFuncMatchesSlicesSortFunc(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 17, 100, 1000} {
+		// Many repeated ages, so ties are common.
+		employees := make([]employee, size)
+		for i := range employees {
+			employees[i] = employee{Name: fmt.Sprintf("e%d", i), Age: (i * 7919) % 31}
+		}
+
+		want := slices.Clone(employees)
+		slices.SortStableFunc(want, func(a, b employee) int { return a.Age - b.Age })
+
+		for _, sf := range sortFuncs {
+			t.Run(fmt.Sprintf("%s/size_%d", sf.name, size), func(t *testing.T) {
+				got := sf.sort(employees, byAge)
+				if len(got) != len(want) {
+					t.Fatalf("%s returned %d elements, want %d", sf.name, len(got), len(want))
+				}
+
+				if sf.stable {
+					if !cmp.Equal(got, want) {
+						t.Errorf("%s is not stable", sf.name)
+					}
+
+					return
+				}
+
+				// Unstable sorts only have to agree on the order of the keys.
+				for i := range got {
+					if got[i].Age != want[i].Age {
+						t.Fatalf("%s: element %d has age %d, want %d", sf.name, i, got[i].Age, want[i].Age)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestSortFuncOtherTypes(t *testing.T) {
+	words := []string{"pear", "Apple", "fig", "banana", "Cherry"}
+	want := []string{"Apple", "banana", "Cherry", "fig", "pear"}
+	caseless := func(a, b string) bool { return strings.ToLower(a) < strings.ToLower(b) }
+
+	if got := QuickSortFunc(words, caseless); !cmp.Equal(got, want) {
+		t.Errorf("QuickSortFunc(caseless) = %v, want %v", got, want)
+	}
+
+	if got := HeapSortFunc(words, caseless); !cmp.Equal(got, want) {
+		t.Errorf("HeapSortFunc(caseless) = %v, want %v", got, want)
+	}
+
+	if got := MergeSortFunc(words, caseless); !cmp.Equal(got, want) {
+		t.Errorf("MergeSortFunc(caseless) = %v, want %v", got, want)
+	}
+
+	// Descending order is just a reversed comparator.
+	nums := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	descending := func(a, b int) bool { return a > b }
+	if got, want := MergeSortFunc(nums, descending), []int{9, 6, 5, 4, 3, 2, 1, 1}; !cmp.Equal(got, want) {
+		t.Errorf("MergeSortFunc(descending) = %v, want %v", got, want)
+	}
+}
+
+func TestMergeSortFuncSingleElementIsCopy(t *testing.T) {
+	arr := []int{7}
+	got := MergeSortFunc(arr, func(a, b int) bool { return a < b })
+	got[0] = 8
+
+	if arr[0] != 7 {
+		t.Errorf("MergeSortFunc returned a slice sharing storage with its input")
+	}
+}
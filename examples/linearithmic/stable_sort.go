@@ -0,0 +1,28 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linearithmic
+
+import "cmp"
+
+// StableMergeSort performs O(n log n) stable sort and returns a sorted copy
+// of arr. Stable means elements that compare equal keep the order they had
+// in the input. For plain ints that is not observable, since equal ints are
+// indistinguishable, but the guarantee matters as soon as the values carry
+// more than their key; MergeSortFunc, which this uses, sorts any type with
+// the same guarantee. QuickSort, HeapSort, and IntroSort make no such
+// guarantee.
+func StableMergeSort(arr []int) []int {
+	return MergeSortFunc(arr, cmp.Less[int])
+}
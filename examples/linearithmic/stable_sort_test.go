@@ -0,0 +1,93 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linearithmic
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// record is a value with a sort key and its position in the input, so the
+// effect of stability can be seen in the output.
+type record struct {
+	Key int
+	Seq int
+}
+
+func byKey(a, b record) bool { return a.Key < b.Key }
+
+func TestStableMergeSort(t *testing.T) {
+	tests := []struct {
+		name string
+		arr  []int
+	}{
+		{"empty array", []int{}},
+		{"single element", []int{5}},
+		{"two elements", []int{2, 1}},
+		{"already sorted", []int{1, 2, 3, 4, 5}},
+		{"reverse sorted", []int{5, 4, 3, 2, 1}},
+		{"duplicates", []int{3, 1, 3, 1, 3}},
+		{"negative numbers", []int{-1, -5, 0, 3, -2}},
+		{"odd length", []int{9, 8, 7, 1, 2, 3, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := slices.Clone(tt.arr)
+			want := slices.Clone(tt.arr)
+			sort.Ints(want)
+
+			if got := StableMergeSort(tt.arr); !cmp.Equal(got, want) {
+				t.Errorf("StableMergeSort(%v) = %v, want %v", tt.arr, got, want)
+			}
+
+			if !cmp.Equal(tt.arr, original) {
+				t.Errorf("StableMergeSort modified its input: got %v, want %v", tt.arr, original)
+			}
+		})
+	}
+}
+
+func TestMergeSortFuncPreservesSeqOrder(t *testing.T) {
+	for _, size := range []int{2, 3, 7, 64, 100, 1000} {
+		t.Run(fmt.Sprintf("size_%d", size), func(t *testing.T) {
+			// Few distinct keys, so each key is shared by many records.
+			records := make([]record, size)
+			for i := range records {
+				records[i] = record{Key: (i * 7919) % 5, Seq: i}
+			}
+
+			got := MergeSortFunc(records, byKey)
+			if len(got) != size {
+				t.Fatalf("MergeSortFunc() returned %d records, want %d", len(got), size)
+			}
+
+			for i := 1; i < len(got); i++ {
+				prev, cur := got[i-1], got[i]
+				if prev.Key > cur.Key {
+					t.Fatalf("records %d and %d out of key order: %v then %v", i-1, i, prev, cur)
+				}
+
+				if prev.Key == cur.Key && prev.Seq > cur.Seq {
+					t.Fatalf("records %d and %d with key %d out of seq order: %d then %d", i-1, i, cur.Key, prev.Seq, cur.Seq)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logarithmic
+
+// BinarySearch returns the index of target in sorted, or -1 if it is not
+// present - O(log n). Each comparison halves the range still to be searched,
+// so a slice of a million elements needs at most 20 comparisons.
+//
+// sorted must be in ascending order. If target appears more than once, the
+// index of any one of the copies may be returned. The search is iterative,
+// so it needs no stack beyond a few locals.
+func BinarySearch(sorted []int, target int) int {
+	low, high := 0, len(sorted)-1
+
+	for low <= high {
+		// Written this way rather than (low+high)/2 so the sum cannot
+		// overflow on very large slices.
+		mid := low + (high-low)/2
+
+		switch {
+		case sorted[mid] < target:
+			low = mid + 1
+		case sorted[mid] > target:
+			high = mid - 1
+		default:
+			return mid
+		}
+	}
+
+	return -1
+}
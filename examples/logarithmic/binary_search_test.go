@@ -0,0 +1,81 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logarithmic
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBinarySearch(t *testing.T) {
+	sorted := []int{-7, -2, 0, 3, 3, 8, 15, 21, 40}
+
+	tests := []struct {
+		name   string
+		sorted []int
+		target int
+		want   int
+	}{
+		{"first element", sorted, -7, 0},
+		{"middle element", sorted, 8, 5},
+		{"last element", sorted, 40, 8},
+		{"absent, below all", sorted, -100, -1},
+		{"absent, above all", sorted, 100, -1},
+		{"absent, between elements", sorted, 4, -1},
+		{"empty slice", []int{}, 3, -1},
+		{"nil slice", nil, 3, -1},
+		{"single element, present", []int{5}, 5, 0},
+		{"single element, absent", []int{5}, 6, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BinarySearch(tt.sorted, tt.target); got != tt.want {
+				t.Errorf("BinarySearch(%v, %d) = %d, want %d", tt.sorted, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBinarySearchDuplicates(t *testing.T) {
+	sorted := []int{1, 3, 3, 3, 3, 9}
+
+	got := BinarySearch(sorted, 3)
+	if got < 1 || got > 4 {
+		t.Errorf("BinarySearch(%v, 3) = %d, want an index from 1 to 4", sorted, got)
+	}
+}
+
+func TestBinarySearchEveryElement(t *testing.T) {
+	for _, size := range []int{1, 2, 3, 16, 17, 1000} {
+		t.Run(fmt.Sprintf("size_%d", size), func(t *testing.T) {
+			// Even values only, so every odd value is a gap.
+			sorted := make([]int, size)
+			for i := range sorted {
+				sorted[i] = 2 * i
+			}
+
+			for i, v := range sorted {
+				if got := BinarySearch(sorted, v); got != i {
+					t.Fatalf("BinarySearch(%d) = %d, want %d", v, got, i)
+				}
+
+				if got := BinarySearch(sorted, v+1); got != -1 {
+					t.Fatalf("BinarySearch(%d) = %d, want -1", v+1, got)
+				}
+			}
+		})
+	}
+}
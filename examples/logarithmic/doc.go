@@ -27,8 +27,9 @@
 //   - n=1073741824: log₂(1073741824) = 30 operations
 //
 // Common use cases include binary search in sorted arrays, binary tree
-// traversal, heap operations (insert/delete), and divide-and-conquer
-// algorithms that split the problem space in half with each iteration.
+// traversal, heap operations (insert/delete), segment tree range queries
+// and updates (see SegmentTree), and divide-and-conquer algorithms that
+// split the problem space in half with each iteration.
 //
 // Benchmarks can test large input sizes (n ≤ 10^6) efficiently due to
 // the slow growth rate of logarithmic functions.
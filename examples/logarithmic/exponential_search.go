@@ -0,0 +1,52 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logarithmic
+
+// ExponentialSearch returns the index of target in sorted, or -1 if it is
+// not present - O(log i), where i is the index target would occupy.
+//
+// Rather than starting with the whole slice, it probes indexes 1, 2, 4, 8,
+// and so on until it passes target or the end of the slice, then runs
+// BinarySearch over the last doubling. Both steps take O(log i), which is
+// never worse than BinarySearch's O(log n) and much better when target is
+// near the front. Because it only looks as far as it needs to, the same
+// approach works on inputs whose length is unknown or unbounded.
+//
+// sorted must be in ascending order. If target appears more than once, the
+// index of any one of the copies may be returned.
+func ExponentialSearch(sorted []int, target int) int {
+	if len(sorted) == 0 {
+		return -1
+	}
+
+	if sorted[0] == target {
+		return 0
+	}
+
+	bound := 1
+	for bound < len(sorted) && sorted[bound] < target {
+		bound *= 2
+	}
+
+	// target is now known to lie after bound/2 and at or before bound.
+	low := bound / 2
+	high := min(bound+1, len(sorted))
+
+	if i := BinarySearch(sorted[low:high], target); i != -1 {
+		return low + i
+	}
+
+	return -1
+}
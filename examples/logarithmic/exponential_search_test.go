@@ -0,0 +1,73 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logarithmic
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestExponentialSearch(t *testing.T) {
+	sorted := []int{-7, -2, 0, 3, 3, 8, 15, 21, 40, 41, 57}
+
+	tests := []struct {
+		name   string
+		sorted []int
+		target int
+		want   int
+	}{
+		{"index 0", sorted, -7, 0},
+		{"index 1", sorted, -2, 1},
+		{"power of two index", sorted, 21, 7},
+		{"just past a power of two", sorted, 41, 9},
+		{"last element", sorted, 57, 10},
+		{"absent, below all", sorted, -100, -1},
+		{"absent, beyond the array", sorted, 100, -1},
+		{"absent, between elements", sorted, 16, -1},
+		{"empty slice", []int{}, 3, -1},
+		{"nil slice", nil, 3, -1},
+		{"single element, present", []int{5}, 5, 0},
+		{"single element, below", []int{5}, 4, -1},
+		{"single element, above", []int{5}, 6, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExponentialSearch(tt.sorted, tt.target); got != tt.want {
+				t.Errorf("ExponentialSearch(%v, %d) = %d, want %d", tt.sorted, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExponentialSearchMatchesBinarySearch(t *testing.T) {
+	for _, size := range []int{1, 2, 3, 4, 5, 31, 32, 33, 1000} {
+		t.Run(fmt.Sprintf("size_%d", size), func(t *testing.T) {
+			// Distinct even values, so both searches must agree on the
+			// index of every present value and on -1 for every gap.
+			sorted := make([]int, size)
+			for i := range sorted {
+				sorted[i] = 2 * i
+			}
+
+			for target := -1; target <= 2*size; target++ {
+				want := BinarySearch(sorted, target)
+				if got := ExponentialSearch(sorted, target); got != want {
+					t.Fatalf("ExponentialSearch(%d) = %d, want %d", target, got, want)
+				}
+			}
+		})
+	}
+}
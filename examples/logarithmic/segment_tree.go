@@ -0,0 +1,151 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logarithmic
+
+// SegmentTree supports range-sum queries and range-add updates over a fixed
+// slice of integers, both in O(log n).
+//
+// Each node covers a contiguous range of the input and stores its sum. A
+// range update that fully covers a node records the pending delta in the
+// node's lazy entry instead of visiting its descendants; the delta is pushed
+// down one level only when a later query or update needs to look inside that
+// node. This lazy propagation is what keeps a range update from touching
+// every element in the range.
+type SegmentTree struct {
+	n    int
+	sum  []int
+	lazy []int
+}
+
+// NewSegmentTree builds a SegmentTree over the values in arr - O(n).
+func NewSegmentTree(arr []int) *SegmentTree {
+	st := &SegmentTree{
+		n:    len(arr),
+		sum:  make([]int, 4*len(arr)),
+		lazy: make([]int, 4*len(arr)),
+	}
+
+	if st.n > 0 {
+		st.build(arr, 1, 0, st.n-1)
+	}
+
+	return st
+}
+
+// Len returns the number of elements in the tree.
+func (st *SegmentTree) Len() int {
+	return st.n
+}
+
+// Query returns the sum of the elements at indexes l through r
+// inclusive - O(log n). It returns 0 if l > r and panics if l or r is
+// out of range.
+func (st *SegmentTree) Query(l, r int) int {
+	if l > r {
+		return 0
+	}
+
+	st.checkRange(l, r)
+
+	return st.query(1, 0, st.n-1, l, r)
+}
+
+// RangeUpdate adds delta to every element at indexes l through r
+// inclusive - O(log n). It does nothing if l > r and panics if l or r is
+// out of range.
+func (st *SegmentTree) RangeUpdate(l, r, delta int) {
+	if l > r {
+		return
+	}
+
+	st.checkRange(l, r)
+	st.update(1, 0, st.n-1, l, r, delta)
+}
+
+// checkRange panics if l or r falls outside the tree.
+func (st *SegmentTree) checkRange(l, r int) {
+	if l < 0 || r >= st.n {
+		panic("logarithmic: SegmentTree index out of range")
+	}
+}
+
+// build fills in node, which covers arr[lo:hi+1], and its descendants.
+func (st *SegmentTree) build(arr []int, node, lo, hi int) {
+	if lo == hi {
+		st.sum[node] = arr[lo]
+
+		return
+	}
+
+	mid := lo + (hi-lo)/2
+	st.build(arr, 2*node, lo, mid)
+	st.build(arr, 2*node+1, mid+1, hi)
+	st.sum[node] = st.sum[2*node] + st.sum[2*node+1]
+}
+
+// apply adds delta to every element covered by node, which spans size
+// elements, deferring the work for its descendants.
+func (st *SegmentTree) apply(node, size, delta int) {
+	st.sum[node] += delta * size
+	st.lazy[node] += delta
+}
+
+// push moves the pending delta of node, which covers [lo, hi], down to its
+// two children.
+func (st *SegmentTree) push(node, lo, hi int) {
+	if st.lazy[node] == 0 {
+		return
+	}
+
+	mid := lo + (hi-lo)/2
+	st.apply(2*node, mid-lo+1, st.lazy[node])
+	st.apply(2*node+1, hi-mid, st.lazy[node])
+	st.lazy[node] = 0
+}
+
+// query returns the sum of [l, r] within node, which covers [lo, hi].
+func (st *SegmentTree) query(node, lo, hi, l, r int) int {
+	if r < lo || hi < l {
+		return 0
+	}
+
+	if l <= lo && hi <= r {
+		return st.sum[node]
+	}
+
+	st.push(node, lo, hi)
+	mid := lo + (hi-lo)/2
+
+	return st.query(2*node, lo, mid, l, r) + st.query(2*node+1, mid+1, hi, l, r)
+}
+
+// update adds delta to [l, r] within node, which covers [lo, hi].
+func (st *SegmentTree) update(node, lo, hi, l, r, delta int) {
+	if r < lo || hi < l {
+		return
+	}
+
+	if l <= lo && hi <= r {
+		st.apply(node, hi-lo+1, delta)
+
+		return
+	}
+
+	st.push(node, lo, hi)
+	mid := lo + (hi-lo)/2
+	st.update(2*node, lo, mid, l, r, delta)
+	st.update(2*node+1, mid+1, hi, l, r, delta)
+	st.sum[node] = st.sum[2*node] + st.sum[2*node+1]
+}
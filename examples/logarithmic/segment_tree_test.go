@@ -0,0 +1,168 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logarithmic
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// naiveSum returns the sum of arr[l:r+1].
+func naiveSum(arr []int, l, r int) int {
+	sum := 0
+	for _, v := range arr[l : r+1] {
+		sum += v
+	}
+
+	return sum
+}
+
+func TestSegmentTreeQuery(t *testing.T) {
+	arr := []int{5, -2, 7, 0, 3, 3, -8, 1, 4}
+	st := NewSegmentTree(arr)
+
+	if got := st.Len(); got != len(arr) {
+		t.Errorf("Len() = %d, want %d", got, len(arr))
+	}
+
+	for l := range arr {
+		for r := l; r < len(arr); r++ {
+			if got, want := st.Query(l, r), naiveSum(arr, l, r); got != want {
+				t.Errorf("Query(%d, %d) = %d, want %d", l, r, got, want)
+			}
+		}
+	}
+
+	if got := st.Query(4, 3); got != 0 {
+		t.Errorf("Query(4, 3) = %d, want 0", got)
+	}
+}
+
+func TestSegmentTreeRangeUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		arr      []int
+		l, r     int
+		delta    int
+		wantSums [][3]int // {l, r, sum} after the update
+	}{
+		{
+			name:     "whole range",
+			arr:      []int{1, 2, 3, 4},
+			l:        0,
+			r:        3,
+			delta:    10,
+			wantSums: [][3]int{{0, 3, 50}, {1, 1, 12}, {2, 3, 27}},
+		},
+		{
+			name:     "single element",
+			arr:      []int{1, 2, 3, 4},
+			l:        2,
+			r:        2,
+			delta:    -3,
+			wantSums: [][3]int{{0, 3, 7}, {2, 2, 0}, {0, 1, 3}},
+		},
+		{
+			name:     "partial overlap",
+			arr:      []int{0, 0, 0, 0, 0, 0, 0},
+			l:        2,
+			r:        5,
+			delta:    1,
+			wantSums: [][3]int{{0, 6, 4}, {0, 2, 1}, {5, 6, 1}, {3, 4, 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := NewSegmentTree(tt.arr)
+			st.RangeUpdate(tt.l, tt.r, tt.delta)
+
+			for _, w := range tt.wantSums {
+				if got := st.Query(w[0], w[1]); got != w[2] {
+					t.Errorf("after RangeUpdate(%d, %d, %d), Query(%d, %d) = %d, want %d",
+						tt.l, tt.r, tt.delta, w[0], w[1], got, w[2])
+				}
+			}
+		})
+	}
+}
+
+func TestSegmentTreeMatchesNaive(t *testing.T) {
+	const n = 257
+
+	r := rand.New(rand.NewSource(1))
+
+	arr := make([]int, n)
+	for i := range arr {
+		arr[i] = r.Intn(200) - 100
+	}
+
+	st := NewSegmentTree(arr)
+
+	for range 2000 {
+		l := r.Intn(n)
+		hi := l + r.Intn(n-l)
+
+		if r.Intn(2) == 0 {
+			delta := r.Intn(20) - 10
+			st.RangeUpdate(l, hi, delta)
+			for i := l; i <= hi; i++ {
+				arr[i] += delta
+			}
+
+			continue
+		}
+
+		if got, want := st.Query(l, hi), naiveSum(arr, l, hi); got != want {
+			t.Fatalf("Query(%d, %d) = %d, want %d", l, hi, got, want)
+		}
+	}
+}
+
+func TestSegmentTreeEmpty(t *testing.T) {
+	st := NewSegmentTree(nil)
+
+	if got := st.Len(); got != 0 {
+		t.Errorf("Len() = %d, want 0", got)
+	}
+
+	if got := st.Query(0, -1); got != 0 {
+		t.Errorf("Query(0, -1) = %d, want 0", got)
+	}
+}
+
+func TestSegmentTreeOutOfRange(t *testing.T) {
+	st := NewSegmentTree([]int{1, 2, 3})
+
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"Query past end", func() { st.Query(0, 3) }},
+		{"Query negative", func() { st.Query(-1, 1) }},
+		{"RangeUpdate past end", func() { st.RangeUpdate(2, 3, 1) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", tt.name)
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
@@ -26,9 +26,10 @@
 //   - n=4294967296: log₂(log₂(4294967296)) = 5 operations
 //   - n=2⁶⁴: log₂(log₂(2⁶⁴)) = 6 operations
 //
-// Common use cases include van Emde Boas trees for successor/predecessor queries,
-// interpolation search on uniformly distributed data, fusion trees for integer
-// sorting, and Y-fast tries for dynamic predecessor problems.
+// Common use cases include van Emde Boas trees (see VEBTree) for
+// successor/predecessor queries, interpolation search on uniformly
+// distributed data, fusion trees for integer sorting, and Y-fast tries (see
+// YFastTrie) for dynamic predecessor problems.
 //
 // Benchmarks can efficiently test very large input sizes (n ≤ 10^9) due to
 // the extremely slow growth of the double logarithm function.
@@ -14,41 +14,13 @@
 
 package loglog
 
-import "sync"
+import "github.com/rsned/bigo/examples/linear"
 
-// ParallelDivideConquer performs O(log(log n)) parallel divide-and-conquer
+// ParallelDivideConquer returns the maximum of arr, or 0 if arr is empty,
+// splitting the work across goroutines. Its total work is O(n), not
+// O(log(log n)).
+//
+// Deprecated: Use linear.ParallelDivideConquer instead.
 func ParallelDivideConquer(arr []int) int {
-	if len(arr) <= 1 {
-		if len(arr) == 0 {
-			return 0
-		}
-
-		return arr[0]
-	}
-
-	numWorkers := 2
-	mid := len(arr) / 2
-
-	var wg sync.WaitGroup
-	results := make([]int, numWorkers)
-
-	wg.Add(2)
-
-	go func() {
-		defer wg.Done()
-		results[0] = ParallelDivideConquer(arr[:mid])
-	}()
-
-	go func() {
-		defer wg.Done()
-		results[1] = ParallelDivideConquer(arr[mid:])
-	}()
-
-	wg.Wait()
-
-	if results[0] > results[1] {
-		return results[0]
-	}
-
-	return results[1]
+	return linear.ParallelDivideConquer(arr)
 }
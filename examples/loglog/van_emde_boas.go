@@ -16,7 +16,8 @@ package loglog
 
 import "math"
 
-// VanEmdeBoas represents a simplified Van Emde Boas tree structure
+// VanEmdeBoas represents a simplified Van Emde Boas tree structure that only
+// supports predecessor queries. See VEBTree for the full set of operations.
 type VanEmdeBoas struct {
 	universeSize int
 	min, max     *int
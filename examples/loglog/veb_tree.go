@@ -0,0 +1,338 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loglog
+
+import "math/bits"
+
+// vebNone marks an empty VEBTree, matching NIL in the CLRS presentation.
+const vebNone = -1
+
+// VEBTree is a van Emde Boas tree holding a set of integers from a fixed
+// universe 0 through u-1, where u is a power of two.
+//
+// A tree over u values splits each value into a high half, selecting one of
+// √u clusters, and a low half within that cluster. Each cluster is itself a
+// VEBTree over √u values, and a summary VEBTree over the cluster numbers
+// records which clusters are non-empty. The minimum is kept outside the
+// clusters, which lets every operation recurse into at most one subtree of
+// half the bit width, so Insert, Delete, Member, Successor, and Predecessor
+// are all O(log log u).
+//
+// Clusters and summaries are only allocated once something is inserted into
+// them, so an empty tree is O(1) and the space used grows with the values
+// inserted rather than with u.
+type VEBTree struct {
+	u        int // Universe size, a power of two >= 2.
+	min, max int // vebNone when the tree is empty.
+	lowBits  int // Number of bits in the low half of a value.
+	summary  *VEBTree
+	clusters []*VEBTree
+}
+
+// NewVEBTree creates an empty VEBTree over the universe 0 through u-1.
+// It panics if u is not a power of two of at least 2.
+func NewVEBTree(u int) *VEBTree {
+	if u < 2 || u&(u-1) != 0 {
+		panic("loglog: VEBTree universe size must be a power of two >= 2")
+	}
+
+	t := &VEBTree{
+		u:        u,
+		min:      vebNone,
+		max:      vebNone,
+		lowBits:  0,
+		summary:  nil,
+		clusters: nil,
+	}
+
+	if u > 2 {
+		t.lowBits = (bits.Len(uint(u)) - 1) / 2
+		t.clusters = make([]*VEBTree, u>>t.lowBits)
+	}
+
+	return t
+}
+
+// UniverseSize returns u, the number of distinct values the tree can hold.
+func (t *VEBTree) UniverseSize() int {
+	return t.u
+}
+
+// IsEmpty reports whether the tree holds no values - O(1).
+func (t *VEBTree) IsEmpty() bool {
+	return t.min == vebNone
+}
+
+// Min returns the smallest value in the tree - O(1).
+// The boolean is false if the tree is empty.
+func (t *VEBTree) Min() (int, bool) {
+	return t.min, t.min != vebNone
+}
+
+// Max returns the largest value in the tree - O(1).
+// The boolean is false if the tree is empty.
+func (t *VEBTree) Max() (int, bool) {
+	return t.max, t.max != vebNone
+}
+
+// Member reports whether x is in the tree - O(log log u).
+// It panics if x is outside the universe.
+func (t *VEBTree) Member(x int) bool {
+	t.checkRange(x)
+
+	return t.member(x)
+}
+
+// Insert adds x to the tree - O(log log u). Inserting a value that is
+// already present does nothing. It panics if x is outside the universe.
+func (t *VEBTree) Insert(x int) {
+	t.checkRange(x)
+
+	if t.member(x) {
+		return
+	}
+
+	t.insert(x)
+}
+
+// Delete removes x from the tree - O(log log u). Deleting a value that is
+// not present does nothing. It panics if x is outside the universe.
+func (t *VEBTree) Delete(x int) {
+	t.checkRange(x)
+
+	if !t.member(x) {
+		return
+	}
+
+	t.delete(x)
+}
+
+// Successor returns the smallest value in the tree that is greater than
+// x - O(log log u). The boolean is false if there is none. It panics if x
+// is outside the universe.
+func (t *VEBTree) Successor(x int) (int, bool) {
+	t.checkRange(x)
+	s := t.successor(x)
+
+	return s, s != vebNone
+}
+
+// Predecessor returns the largest value in the tree that is less than
+// x - O(log log u). The boolean is false if there is none. It panics if x
+// is outside the universe.
+func (t *VEBTree) Predecessor(x int) (int, bool) {
+	t.checkRange(x)
+	p := t.predecessor(x)
+
+	return p, p != vebNone
+}
+
+// checkRange panics if x is outside the universe.
+func (t *VEBTree) checkRange(x int) {
+	if x < 0 || x >= t.u {
+		panic("loglog: VEBTree value out of range")
+	}
+}
+
+// high returns the cluster number of x.
+func (t *VEBTree) high(x int) int {
+	return x >> t.lowBits
+}
+
+// low returns the position of x within its cluster.
+func (t *VEBTree) low(x int) int {
+	return x & (1<<t.lowBits - 1)
+}
+
+// index rebuilds a value from its cluster number and position.
+func (t *VEBTree) index(high, low int) int {
+	return high<<t.lowBits | low
+}
+
+// cluster returns cluster h, creating it if needed.
+func (t *VEBTree) cluster(h int) *VEBTree {
+	if t.clusters[h] == nil {
+		t.clusters[h] = NewVEBTree(1 << t.lowBits)
+	}
+
+	return t.clusters[h]
+}
+
+// summaryTree returns the summary, creating it if needed.
+func (t *VEBTree) summaryTree() *VEBTree {
+	if t.summary == nil {
+		t.summary = NewVEBTree(len(t.clusters))
+	}
+
+	return t.summary
+}
+
+func (t *VEBTree) member(x int) bool {
+	if x == t.min || x == t.max {
+		return true
+	}
+
+	if t.u == 2 {
+		return false
+	}
+
+	c := t.clusters[t.high(x)]
+
+	return c != nil && c.member(t.low(x))
+}
+
+// insert adds x, which must not already be present.
+func (t *VEBTree) insert(x int) {
+	if t.min == vebNone {
+		t.min, t.max = x, x
+
+		return
+	}
+
+	// The minimum is not stored in a cluster, so a new minimum pushes the
+	// old one down instead.
+	if x < t.min {
+		x, t.min = t.min, x
+	}
+
+	if t.u > 2 {
+		h, l := t.high(x), t.low(x)
+		c := t.cluster(h)
+
+		// Inserting into an empty cluster is O(1), so only one of the two
+		// recursive calls does real work.
+		if c.min == vebNone {
+			t.summaryTree().insert(h)
+			c.min, c.max = l, l
+		} else {
+			c.insert(l)
+		}
+	}
+
+	if x > t.max {
+		t.max = x
+	}
+}
+
+// delete removes x, which must be present.
+func (t *VEBTree) delete(x int) {
+	if t.min == t.max {
+		t.min, t.max = vebNone, vebNone
+
+		return
+	}
+
+	if t.u == 2 {
+		t.min = 1 - x
+		t.max = t.min
+
+		return
+	}
+
+	// Deleting the minimum promotes the smallest clustered value to take
+	// its place, and that value is then deleted from its cluster.
+	if x == t.min {
+		first := t.summary.min
+		x = t.index(first, t.clusters[first].min)
+		t.min = x
+	}
+
+	h := t.high(x)
+	c := t.clusters[h]
+	c.delete(t.low(x))
+
+	// As with insert, when the cluster empties its own delete was O(1).
+	if c.min == vebNone {
+		t.summary.delete(h)
+
+		if x == t.max {
+			if t.summary.min == vebNone {
+				t.max = t.min
+			} else {
+				last := t.summary.max
+				t.max = t.index(last, t.clusters[last].max)
+			}
+		}
+	} else if x == t.max {
+		t.max = t.index(h, c.max)
+	}
+}
+
+func (t *VEBTree) successor(x int) int {
+	if t.u == 2 {
+		if x == 0 && t.max == 1 {
+			return 1
+		}
+
+		return vebNone
+	}
+
+	if t.min != vebNone && x < t.min {
+		return t.min
+	}
+
+	// The answer is in x's own cluster if that cluster holds anything
+	// larger; otherwise it is the minimum of the next non-empty cluster.
+	h, l := t.high(x), t.low(x)
+	if c := t.clusters[h]; c != nil && c.max != vebNone && l < c.max {
+		return t.index(h, c.successor(l))
+	}
+
+	if t.summary == nil {
+		return vebNone
+	}
+
+	next := t.summary.successor(h)
+	if next == vebNone {
+		return vebNone
+	}
+
+	return t.index(next, t.clusters[next].min)
+}
+
+func (t *VEBTree) predecessor(x int) int {
+	if t.u == 2 {
+		if x == 1 && t.min == 0 {
+			return 0
+		}
+
+		return vebNone
+	}
+
+	if t.max != vebNone && x > t.max {
+		return t.max
+	}
+
+	h, l := t.high(x), t.low(x)
+	if c := t.clusters[h]; c != nil && c.min != vebNone && l > c.min {
+		return t.index(h, c.predecessor(l))
+	}
+
+	prev := vebNone
+	if t.summary != nil {
+		prev = t.summary.predecessor(h)
+	}
+
+	if prev == vebNone {
+		// The minimum lives outside the clusters, so check it last.
+		if t.min != vebNone && x > t.min {
+			return t.min
+		}
+
+		return vebNone
+	}
+
+	return t.index(prev, t.clusters[prev].max)
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loglog
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// checkVEBTree compares every query on t against the reference set.
+func checkVEBTree(t *testing.T, tree *VEBTree, set map[int]bool) {
+	t.Helper()
+
+	u := tree.UniverseSize()
+
+	wantMin, wantMax := vebNone, vebNone
+	for x := range u {
+		if set[x] {
+			if wantMin == vebNone {
+				wantMin = x
+			}
+			wantMax = x
+		}
+	}
+
+	if got, ok := tree.Min(); got != wantMin || ok != (wantMin != vebNone) {
+		t.Fatalf("Min() = %d, %t, want %d", got, ok, wantMin)
+	}
+
+	if got, ok := tree.Max(); got != wantMax || ok != (wantMax != vebNone) {
+		t.Fatalf("Max() = %d, %t, want %d", got, ok, wantMax)
+	}
+
+	if got := tree.IsEmpty(); got != (len(set) == 0) {
+		t.Fatalf("IsEmpty() = %t, want %t", got, len(set) == 0)
+	}
+
+	for x := range u {
+		if got := tree.Member(x); got != set[x] {
+			t.Fatalf("Member(%d) = %t, want %t", x, got, set[x])
+		}
+
+		wantSucc := vebNone
+		for y := x + 1; y < u; y++ {
+			if set[y] {
+				wantSucc = y
+
+				break
+			}
+		}
+
+		if got, ok := tree.Successor(x); got != wantSucc || ok != (wantSucc != vebNone) {
+			t.Fatalf("Successor(%d) = %d, %t, want %d", x, got, ok, wantSucc)
+		}
+
+		wantPred := vebNone
+		for y := x - 1; y >= 0; y-- {
+			if set[y] {
+				wantPred = y
+
+				break
+			}
+		}
+
+		if got, ok := tree.Predecessor(x); got != wantPred || ok != (wantPred != vebNone) {
+			t.Fatalf("Predecessor(%d) = %d, %t, want %d", x, got, ok, wantPred)
+		}
+	}
+}
+
+func TestVEBTree(t *testing.T) {
+	tree := NewVEBTree(16)
+	for _, x := range []int{2, 3, 4, 5, 7, 14, 15} {
+		tree.Insert(x)
+	}
+
+	tests := []struct {
+		x        int
+		succ     int
+		succOK   bool
+		pred     int
+		predOK   bool
+		isMember bool
+	}{
+		{0, 2, true, 0, false, false},
+		{2, 3, true, 0, false, true},
+		{5, 7, true, 4, true, true},
+		{6, 7, true, 5, true, false},
+		{7, 14, true, 5, true, true},
+		{10, 14, true, 7, true, false},
+		{15, 0, false, 14, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("x=%d", tt.x), func(t *testing.T) {
+			if got := tree.Member(tt.x); got != tt.isMember {
+				t.Errorf("Member(%d) = %t, want %t", tt.x, got, tt.isMember)
+			}
+
+			if got, ok := tree.Successor(tt.x); ok != tt.succOK || (ok && got != tt.succ) {
+				t.Errorf("Successor(%d) = %d, %t, want %d, %t", tt.x, got, ok, tt.succ, tt.succOK)
+			}
+
+			if got, ok := tree.Predecessor(tt.x); ok != tt.predOK || (ok && got != tt.pred) {
+				t.Errorf("Predecessor(%d) = %d, %t, want %d, %t", tt.x, got, ok, tt.pred, tt.predOK)
+			}
+		})
+	}
+}
+
+func TestVEBTreeMatchesSet(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, u := range []int{2, 4, 8, 32, 256} {
+		t.Run(fmt.Sprintf("u=%d", u), func(t *testing.T) {
+			tree := NewVEBTree(u)
+			set := map[int]bool{}
+
+			for range 4 * u {
+				x := r.Intn(u)
+				if r.Intn(3) == 0 {
+					tree.Delete(x)
+					delete(set, x)
+				} else {
+					tree.Insert(x)
+					set[x] = true
+				}
+
+				checkVEBTree(t, tree, set)
+			}
+
+			// Drain the tree completely.
+			for x := range set {
+				tree.Delete(x)
+				delete(set, x)
+				checkVEBTree(t, tree, set)
+			}
+		})
+	}
+}
+
+func TestVEBTreeInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"universe 0", func() { NewVEBTree(0) }},
+		{"universe 1", func() { NewVEBTree(1) }},
+		{"universe not a power of two", func() { NewVEBTree(12) }},
+		{"Insert past end", func() { NewVEBTree(8).Insert(8) }},
+		{"Member negative", func() { NewVEBTree(8).Member(-1) }},
+		{"Successor past end", func() { NewVEBTree(8).Successor(8) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", tt.name)
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
@@ -1,51 +1,101 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
 package loglog
 
 import (
 	"math"
 )
 
-// YFastTrie implements a Y-fast trie for O(log log n) operations
-// Y-fast tries support predecessor, successor, insert, and delete in O(log log n) time
+// YFastTrie implements a Y-fast trie for O(log log u) predecessor and
+// successor queries over the integers 0 through u-1. Unlike VEBTree, whose
+// Predecessor and Successor are strict, a query that is itself a key is its
+// own predecessor and successor here.
+//
+// The keys are split into buckets of O(log u) consecutive keys, each held in
+// a small balanced binary search tree. Each bucket has a representative,
+// and an X-fast trie over the representatives finds the bucket for a query
+// with a binary search over the bit levels, in O(log log u). Searching
+// within the bucket tree is O(log log u) as well, since it holds O(log u)
+// keys.
+//
+// The X-fast trie itself costs O(log u) to update, so it only changes when a
+// bucket grows to twice the target size and splits, or empties completely.
+// Splits happen at most once per O(log u) inserts, which makes Insert and
+// Delete O(log log u) amortized.
 type YFastTrie struct {
-	xFastTrie   *XFastTrie
-	minLeaf     *YNode
-	maxLeaf     *YNode
-	universeLog int
+	xFastTrie    *XFastTrie
+	minLeaf      *yBucket // The first bucket, which also covers keys below its representative.
+	maxLeaf      *yBucket // The last bucket.
+	universeSize int      // Keys must be in [0, universeSize).
+	universeLog  int      // Number of key bits; also the target bucket size.
+	size         int
+}
+
+// yBucket holds the keys from its representative up to, but not including,
+// the next bucket's representative. The buckets form a sorted doubly linked
+// list, which the X-fast trie uses as its leaves.
+type yBucket struct {
+	rep        int
+	root       *YNode
+	size       int
+	prev, next *yBucket
 }
 
-// XFastTrie is a simplified X-fast trie used as a building block
+// XFastTrie is an X-fast trie over the bucket representatives of a
+// YFastTrie. Every prefix of every representative is stored in a hash map
+// per level, so the longest prefix a query shares with any representative
+// can be found by binary searching the levels in O(log log u).
 type XFastTrie struct {
-	levels []map[int]*XNode
-	leaves map[int]*XNode
+	levels []map[int]*XNode // levels[i] holds the i-bit prefixes; levels[height] are the leaves.
 	height int
 }
 
-// XNode represents a node in the X-fast trie
+// XNode represents a node in the X-fast trie. Each node keeps the first and
+// last buckets beneath it, so a query that runs off the trie at a node with
+// only one child can step straight to its predecessor or successor.
 type XNode struct {
-	prefix     int
-	left       *XNode
-	right      *XNode
-	descendant *YNode
+	prefix   int
+	left     *XNode
+	right    *XNode
+	min, max *yBucket
 }
 
-// YNode represents a leaf in the Y-fast trie (balanced BST)
+// YNode is a node of the AVL tree holding the keys of a single bucket.
 type YNode struct {
 	key    int
 	left   *YNode
 	right  *YNode
-	parent *YNode
 	height int
 }
 
-// NewYFastTrie creates a new Y-fast trie for universe size 2^w
+// NewYFastTrie creates a new Y-fast trie for the keys 0 through
+// universeSize-1. It panics if universeSize is not positive.
 func NewYFastTrie(universeSize int) *YFastTrie {
+	if universeSize < 1 {
+		panic("loglog: YFastTrie universe size must be positive")
+	}
+
 	universeLog := int(math.Log2(float64(universeSize))) + 1
 
 	return &YFastTrie{
-		xFastTrie:   NewXFastTrie(universeLog),
-		universeLog: universeLog,
-		minLeaf:     nil,
-		maxLeaf:     nil,
+		xFastTrie:    NewXFastTrie(universeLog),
+		universeSize: universeSize,
+		universeLog:  universeLog,
+		minLeaf:      nil,
+		maxLeaf:      nil,
+		size:         0,
 	}
 }
 
@@ -58,272 +108,477 @@ func NewXFastTrie(w int) *XFastTrie {
 
 	return &XFastTrie{
 		levels: levels,
-		leaves: make(map[int]*XNode),
 		height: w,
 	}
 }
 
-// Insert adds a key to the Y-fast trie
-// Time complexity: O(log log n) amortized
+// Len returns the number of keys in the trie.
+func (yt *YFastTrie) Len() int {
+	return yt.size
+}
+
+// Insert adds a key to the Y-fast trie. Inserting a key that is already
+// present does nothing. It panics if the key is outside the universe.
+// Time complexity: O(log log u) amortized
 func (yt *YFastTrie) Insert(key int) {
-	// Find the appropriate leaf tree or create one
-	leafTree := yt.findLeafTree(key)
-
-	if leafTree == nil {
-		// Create new leaf tree
-		leafTree = &YNode{
-			key:    key,
-			height: 1,
-			left:   nil,
-			right:  nil,
-			parent: nil,
-		}
-		yt.insertIntoXFastTrie(key, leafTree)
+	if !yt.inUniverse(key) {
+		panic("loglog: YFastTrie key out of range")
+	}
 
-		// Update min/max pointers
-		if yt.minLeaf == nil || key < yt.minLeaf.key {
-			yt.minLeaf = leafTree
-		}
-		if yt.maxLeaf == nil || key > yt.maxLeaf.key {
-			yt.maxLeaf = leafTree
-		}
-	} else {
-		// Insert into existing leaf tree (BST)
-		yt.insertIntoBST(leafTree, key)
+	if yt.minLeaf == nil {
+		b := &yBucket{rep: key, root: newYNode(key), size: 1, prev: nil, next: nil}
+		yt.xFastTrie.insert(b)
+		yt.minLeaf, yt.maxLeaf = b, b
+		yt.size = 1
+
+		return
+	}
+
+	b := yt.bucketFor(key)
+	if b.root.contains(key) {
+		return
+	}
+
+	b.root = b.root.insert(key)
+	b.size++
+	yt.size++
+
+	if b.size > 2*yt.universeLog {
+		yt.split(b)
 	}
 }
 
-// Delete removes a key from the Y-fast trie
-// Time complexity: O(log log n) amortized
+// Delete removes a key from the Y-fast trie and reports whether it was
+// present.
+// Time complexity: O(log log u) amortized
 func (yt *YFastTrie) Delete(key int) bool {
-	leafTree := yt.findLeafTree(key)
-	if leafTree == nil {
+	if yt.minLeaf == nil || !yt.inUniverse(key) {
 		return false
 	}
 
-	// Remove from BST
-	if yt.deleteFromBST(leafTree, key) {
-		// If tree becomes empty, remove from X-fast trie
-		if yt.isBSTEmpty(leafTree) {
-			yt.deleteFromXFastTrie(key)
-			// Update min/max pointers if necessary
-			if yt.minLeaf == leafTree {
-				yt.minLeaf = yt.findNextLeaf(leafTree)
-			}
-			if yt.maxLeaf == leafTree {
-				yt.maxLeaf = yt.findPrevLeaf(leafTree)
-			}
-		}
+	b := yt.bucketFor(key)
+	if !b.root.contains(key) {
+		return false
+	}
+
+	b.root = b.root.delete(key)
+	b.size--
+	yt.size--
 
-		return true
+	if b.size == 0 {
+		yt.removeBucket(b)
 	}
 
-	return false
+	return true
 }
 
-// Predecessor finds the largest key ≤ query
-// Time complexity: O(log log n)
+// Predecessor finds the largest key ≤ query. The boolean is false if there
+// is none; a query outside the universe is answered rather than rejected.
+// Time complexity: O(log log u)
 func (yt *YFastTrie) Predecessor(query int) (int, bool) {
-	// Use X-fast trie to find appropriate leaf tree
-	leafTree := yt.findPredecessorLeaf(query)
-	if leafTree == nil {
+	if yt.minLeaf == nil || query < 0 {
 		return 0, false
 	}
 
-	// Search within the BST
-	return yt.predecessorInBST(leafTree, query)
+	b := yt.bucketFor(query)
+	if pred, found := b.root.predecessor(query); found {
+		return pred, true
+	}
+
+	// Every key in earlier buckets is below this bucket's representative.
+	if b.prev != nil {
+		return b.prev.root.maxKey(), true
+	}
+
+	return 0, false
 }
 
-// Successor finds the smallest key ≥ query
-// Time complexity: O(log log n)
+// Successor finds the smallest key ≥ query. The boolean is false if there
+// is none; a query outside the universe is answered rather than rejected.
+// Time complexity: O(log log u)
 func (yt *YFastTrie) Successor(query int) (int, bool) {
-	// Use X-fast trie to find appropriate leaf tree
-	leafTree := yt.findSuccessorLeaf(query)
-	if leafTree == nil {
+	if yt.minLeaf == nil || query >= yt.universeSize {
 		return 0, false
 	}
 
-	// Search within the BST
-	return yt.successorInBST(leafTree, query)
+	b := yt.bucketFor(query)
+	if succ, found := b.root.successor(query); found {
+		return succ, true
+	}
+
+	// Every key in later buckets is at or above their representatives,
+	// which are all greater than query.
+	if b.next != nil {
+		return b.next.root.minKey(), true
+	}
+
+	return 0, false
 }
 
-// Helper methods for X-fast trie operations
+// inUniverse reports whether key can be stored in the trie.
+func (yt *YFastTrie) inUniverse(key int) bool {
+	return key >= 0 && key < yt.universeSize
+}
 
-func (yt *YFastTrie) insertIntoXFastTrie(key int, leafNode *YNode) {
-	// Insert key into X-fast trie with pointer to leaf BST
-	node := &XNode{
-		descendant: leafNode,
-		prefix:     0,
-		left:       nil,
-		right:      nil,
+// bucketFor returns the bucket whose range holds query: the one with the
+// largest representative ≤ query, or the first bucket if there is none.
+// The trie must not be empty.
+func (yt *YFastTrie) bucketFor(query int) *yBucket {
+	if b := yt.xFastTrie.predecessor(query); b != nil {
+		return b
 	}
-	yt.xFastTrie.leaves[key] = node
 
-	// Update all prefixes along the path
-	for level := 0; level <= yt.universeLog; level++ {
-		prefix := key >> (yt.universeLog - level)
-		if _, exists := yt.xFastTrie.levels[level][prefix]; !exists {
-			yt.xFastTrie.levels[level][prefix] = &XNode{
-				prefix:     prefix,
-				descendant: leafNode,
-				left:       nil,
-				right:      nil,
-			}
-		}
+	return yt.minLeaf
+}
+
+// split divides an oversized bucket in half, giving the upper half a new
+// representative in the X-fast trie - O(log u).
+func (yt *YFastTrie) split(b *yBucket) {
+	keys := b.root.inorder(nil)
+	mid := len(keys) / 2
+
+	// The first bucket also holds keys below its representative. Lower the
+	// representative to its smallest key first so the upper half's
+	// representative is sure to come after it.
+	if keys[0] < b.rep {
+		yt.xFastTrie.remove(b)
+		b.rep = keys[0]
+		yt.xFastTrie.insert(b)
+	}
+
+	upper := &yBucket{
+		rep:  keys[mid],
+		root: buildYNodes(keys[mid:]),
+		size: len(keys) - mid,
+		prev: b,
+		next: b.next,
+	}
+
+	b.root = buildYNodes(keys[:mid])
+	b.size = mid
+
+	if b.next != nil {
+		b.next.prev = upper
+	} else {
+		yt.maxLeaf = upper
 	}
+
+	b.next = upper
+	yt.xFastTrie.insert(upper)
 }
 
-func (yt *YFastTrie) deleteFromXFastTrie(key int) {
-	delete(yt.xFastTrie.leaves, key)
+// removeBucket unlinks an empty bucket and drops its representative from the
+// X-fast trie - O(log u). Its range is absorbed by the previous bucket, or
+// by the next one if it was first.
+func (yt *YFastTrie) removeBucket(b *yBucket) {
+	yt.xFastTrie.remove(b)
 
-	// Remove prefixes that are no longer needed
-	for level := 0; level <= yt.universeLog; level++ {
-		prefix := key >> (yt.universeLog - level)
-		// Simplified deletion - in practice would need more complex cleanup
-		delete(yt.xFastTrie.levels[level], prefix)
+	if b.prev != nil {
+		b.prev.next = b.next
+	} else {
+		yt.minLeaf = b.next
 	}
+
+	if b.next != nil {
+		b.next.prev = b.prev
+	} else {
+		yt.maxLeaf = b.prev
+	}
+}
+
+// prefixAt returns the level-bit prefix of key.
+func (xt *XFastTrie) prefixAt(key, level int) int {
+	return key >> (xt.height - level)
 }
 
-func (yt *YFastTrie) findLeafTree(key int) *YNode {
-	if node, exists := yt.xFastTrie.leaves[key]; exists {
-		return node.descendant
+// insert adds the representative of b and all its prefixes - O(log u).
+func (xt *XFastTrie) insert(b *yBucket) {
+	var parent *XNode
+	for level := 0; level <= xt.height; level++ {
+		prefix := xt.prefixAt(b.rep, level)
+
+		node, exists := xt.levels[level][prefix]
+		if !exists {
+			node = &XNode{prefix: prefix, left: nil, right: nil, min: b, max: b}
+			xt.levels[level][prefix] = node
+		}
+
+		if b.rep < node.min.rep {
+			node.min = b
+		}
+
+		if b.rep > node.max.rep {
+			node.max = b
+		}
+
+		if parent != nil {
+			if prefix&1 == 1 {
+				parent.right = node
+			} else {
+				parent.left = node
+			}
+		}
+
+		parent = node
 	}
+}
 
-	return nil
+// remove deletes the representative of b, pruning any prefixes that no
+// longer lead to a leaf and refreshing the first and last buckets of the
+// rest - O(log u).
+func (xt *XFastTrie) remove(b *yBucket) {
+	delete(xt.levels[xt.height], b.rep)
+
+	removed := true
+	for level := xt.height - 1; level >= 0; level-- {
+		node := xt.levels[level][xt.prefixAt(b.rep, level)]
+
+		if removed {
+			if xt.prefixAt(b.rep, level+1)&1 == 1 {
+				node.right = nil
+			} else {
+				node.left = nil
+			}
+		}
+
+		removed = false
+		switch {
+		case node.left == nil && node.right == nil:
+			delete(xt.levels[level], node.prefix)
+			removed = true
+		case node.left == nil:
+			node.min, node.max = node.right.min, node.right.max
+		case node.right == nil:
+			node.min, node.max = node.left.min, node.left.max
+		default:
+			node.min, node.max = node.left.min, node.right.max
+		}
+	}
 }
 
-func (yt *YFastTrie) findPredecessorLeaf(query int) *YNode {
-	// Binary search on levels to find predecessor in O(log log n)
-	low, high := 0, yt.universeLog
-	var bestNode *XNode
+// predecessor returns the bucket with the largest representative ≤ query,
+// or nil if there is none - O(log log u).
+func (xt *XFastTrie) predecessor(query int) *yBucket {
+	if query < 0 || len(xt.levels[0]) == 0 {
+		return nil
+	}
 
-	for low <= high {
-		mid := (low + high) / 2
-		prefix := query >> (yt.universeLog - mid)
+	if query >= 1<<xt.height {
+		return xt.levels[0][0].max
+	}
 
-		if node, exists := yt.xFastTrie.levels[mid][prefix]; exists {
-			bestNode = node
-			high = mid - 1
+	if leaf, exists := xt.levels[xt.height][query]; exists {
+		return leaf.min
+	}
+
+	// Binary search for the deepest level holding a prefix of query. The
+	// root prefix always exists, and a prefix only exists if all of its
+	// shorter prefixes do.
+	low, high := 0, xt.height-1
+	for low < high {
+		mid := (low + high + 1) / 2
+		if _, exists := xt.levels[mid][xt.prefixAt(query, mid)]; exists {
+			low = mid
 		} else {
-			low = mid + 1
+			high = mid - 1
 		}
 	}
 
-	if bestNode != nil {
-		return bestNode.descendant
+	// query leaves the trie below node. If it would have gone right, every
+	// representative beneath node is smaller; otherwise every one is larger
+	// and the predecessor is just before the first of them.
+	node := xt.levels[low][xt.prefixAt(query, low)]
+	if xt.prefixAt(query, low+1)&1 == 1 {
+		return node.max
 	}
 
-	return nil
+	return node.min.prev
 }
 
-func (yt *YFastTrie) findSuccessorLeaf(query int) *YNode {
-	// Similar to predecessor but searching for successor
-	return yt.findPredecessorLeaf(query) // Simplified
+// Helper methods for the bucket AVL trees
+
+func newYNode(key int) *YNode {
+	return &YNode{key: key, left: nil, right: nil, height: 1}
 }
 
-func (yt *YFastTrie) findNextLeaf(_ *YNode) *YNode {
-	// Find next leaf tree in sequence
-	return nil // Simplified
+// buildYNodes builds a balanced tree from sorted keys.
+func buildYNodes(keys []int) *YNode {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	mid := len(keys) / 2
+	node := newYNode(keys[mid])
+	node.left = buildYNodes(keys[:mid])
+	node.right = buildYNodes(keys[mid+1:])
+	node.updateHeight()
+
+	return node
 }
 
-func (yt *YFastTrie) findPrevLeaf(_ *YNode) *YNode {
-	// Find previous leaf tree in sequence
-	return nil // Simplified
+func (n *YNode) getHeight() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
 }
 
-// Helper methods for BST operations
+func (n *YNode) updateHeight() {
+	n.height = 1 + max(n.left.getHeight(), n.right.getHeight())
+}
 
-func (yt *YFastTrie) insertIntoBST(root *YNode, key int) *YNode {
-	if root == nil {
-		return &YNode{
-			key:    key,
-			height: 1,
-			left:   nil,
-			right:  nil,
-			parent: nil,
+func (n *YNode) contains(key int) bool {
+	for n != nil {
+		switch {
+		case key < n.key:
+			n = n.left
+		case key > n.key:
+			n = n.right
+		default:
+			return true
 		}
 	}
 
-	if key < root.key {
-		root.left = yt.insertIntoBST(root.left, key)
-	} else if key > root.key {
-		root.right = yt.insertIntoBST(root.right, key)
+	return false
+}
+
+func (n *YNode) insert(key int) *YNode {
+	if n == nil {
+		return newYNode(key)
 	}
 
-	// Update height and rebalance (AVL tree operations)
-	root.height = 1 + max(yt.getHeight(root.left), yt.getHeight(root.right))
+	switch {
+	case key < n.key:
+		n.left = n.left.insert(key)
+	case key > n.key:
+		n.right = n.right.insert(key)
+	default:
+		return n
+	}
 
-	return yt.rebalance(root)
+	return n.rebalance()
 }
 
-func (yt *YFastTrie) deleteFromBST(root *YNode, key int) bool {
-	if root == nil {
-		return false
+func (n *YNode) delete(key int) *YNode {
+	if n == nil {
+		return nil
 	}
 
-	// Find the key in the BST
-	current := root
-	for current != nil {
-		switch {
-		case current.key == key:
-			return true // Found key - simplified deletion logic
-		case key < current.key:
-			current = current.left
-		default:
-			current = current.right
-		}
+	switch {
+	case key < n.key:
+		n.left = n.left.delete(key)
+	case key > n.key:
+		n.right = n.right.delete(key)
+	case n.left == nil:
+		return n.right
+	case n.right == nil:
+		return n.left
+	default:
+		n.key = n.right.minKey()
+		n.right = n.right.delete(n.key)
 	}
 
-	return false // Key not found
+	return n.rebalance()
 }
 
-func (yt *YFastTrie) predecessorInBST(root *YNode, query int) (int, bool) {
-	if root == nil {
-		return 0, false
-	}
+func (n *YNode) rebalance() *YNode {
+	n.updateHeight()
 
-	if root.key <= query {
-		if pred, found := yt.predecessorInBST(root.right, query); found {
-			return pred, true
+	switch balance := n.left.getHeight() - n.right.getHeight(); {
+	case balance > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft()
 		}
 
-		return root.key, true
+		return n.rotateRight()
+	case balance < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight()
+		}
+
+		return n.rotateLeft()
 	}
 
-	return yt.predecessorInBST(root.left, query)
+	return n
 }
 
-func (yt *YFastTrie) successorInBST(root *YNode, query int) (int, bool) {
-	if root == nil {
-		return 0, false
+func (n *YNode) rotateLeft() *YNode {
+	pivot := n.right
+	n.right = pivot.left
+	pivot.left = n
+	n.updateHeight()
+	pivot.updateHeight()
+
+	return pivot
+}
+
+func (n *YNode) rotateRight() *YNode {
+	pivot := n.left
+	n.left = pivot.right
+	pivot.right = n
+	n.updateHeight()
+	pivot.updateHeight()
+
+	return pivot
+}
+
+func (n *YNode) minKey() int {
+	for n.left != nil {
+		n = n.left
 	}
 
-	if root.key >= query {
-		if succ, found := yt.successorInBST(root.left, query); found {
-			return succ, true
-		}
+	return n.key
+}
 
-		return root.key, true
+func (n *YNode) maxKey() int {
+	for n.right != nil {
+		n = n.right
 	}
 
-	return yt.successorInBST(root.right, query)
+	return n.key
 }
 
-func (yt *YFastTrie) isBSTEmpty(root *YNode) bool {
-	return root == nil
+// predecessor returns the largest key ≤ query in the tree.
+func (n *YNode) predecessor(query int) (int, bool) {
+	pred, found := 0, false
+	for n != nil {
+		if n.key <= query {
+			pred, found = n.key, true
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+
+	return pred, found
 }
 
-func (yt *YFastTrie) getHeight(node *YNode) int {
-	if node == nil {
-		return 0
+// successor returns the smallest key ≥ query in the tree.
+func (n *YNode) successor(query int) (int, bool) {
+	succ, found := 0, false
+	for n != nil {
+		if n.key >= query {
+			succ, found = n.key, true
+			n = n.left
+		} else {
+			n = n.right
+		}
 	}
 
-	return node.height
+	return succ, found
 }
 
-func (yt *YFastTrie) rebalance(node *YNode) *YNode {
-	// AVL tree rebalancing operations
-	return node // Simplified
+// inorder appends the keys of the tree to keys in sorted order.
+func (n *YNode) inorder(keys []int) []int {
+	if n == nil {
+		return keys
+	}
+
+	keys = n.left.inorder(keys)
+	keys = append(keys, n.key)
+
+	return n.right.inorder(keys)
 }
 
 // YFastTrieOperations performs a series of Y-fast trie operations
@@ -1,6 +1,8 @@
 package loglog
 
 import (
+	"math/rand"
+	"slices"
 	"testing"
 )
 
@@ -39,10 +41,13 @@ func TestYFastTriePredecessor(t *testing.T) {
 	}
 
 	// Test predecessor queries
-	if pred, found := trie.Predecessor(25); found {
-		if pred > 25 {
-			t.Errorf("Predecessor of 25 should be ≤ 25, got %d", pred)
-		}
+	if pred, found := trie.Predecessor(25); !found || pred != 20 {
+		t.Errorf("Predecessor(25) = %d, %t, want 20, true", pred, found)
+	}
+
+	// Unlike VEBTree, a key is its own predecessor.
+	if pred, found := trie.Predecessor(30); !found || pred != 30 {
+		t.Errorf("Predecessor(30) = %d, %t, want 30, true", pred, found)
 	}
 
 	// Test predecessor of non-existent key
@@ -61,10 +66,13 @@ func TestYFastTrieSuccessor(t *testing.T) {
 	}
 
 	// Test successor queries
-	if succ, found := trie.Successor(25); found {
-		if succ < 25 {
-			t.Errorf("Successor of 25 should be ≥ 25, got %d", succ)
-		}
+	if succ, found := trie.Successor(25); !found || succ != 30 {
+		t.Errorf("Successor(25) = %d, %t, want 30, true", succ, found)
+	}
+
+	// Unlike VEBTree, a key is its own successor.
+	if succ, found := trie.Successor(30); !found || succ != 30 {
+		t.Errorf("Successor(30) = %d, %t, want 30, true", succ, found)
 	}
 
 	// Test successor beyond max
@@ -132,6 +140,149 @@ func TestYFastTrieEmptyOperations(t *testing.T) {
 	}
 }
 
+// checkYFastTrie compares Predecessor and Successor for every query in
+// [-1, limit] against a binary search of the sorted reference keys. Both
+// include the query itself when it is a key.
+func checkYFastTrie(t *testing.T, trie *YFastTrie, keys []int, limit int) {
+	t.Helper()
+
+	if got := trie.Len(); got != len(keys) {
+		t.Fatalf("Len() = %d, want %d", got, len(keys))
+	}
+
+	for q := -1; q <= limit; q++ {
+		i, found := slices.BinarySearch(keys, q)
+
+		wantPred, wantPredOK := 0, false
+		switch {
+		case found:
+			wantPred, wantPredOK = keys[i], true
+		case i > 0:
+			wantPred, wantPredOK = keys[i-1], true
+		}
+
+		if got, ok := trie.Predecessor(q); got != wantPred || ok != wantPredOK {
+			t.Fatalf("Predecessor(%d) = %d, %t, want %d, %t", q, got, ok, wantPred, wantPredOK)
+		}
+
+		wantSucc, wantSuccOK := 0, false
+		if i < len(keys) {
+			wantSucc, wantSuccOK = keys[i], true
+		}
+
+		if got, ok := trie.Successor(q); got != wantSucc || ok != wantSuccOK {
+			t.Fatalf("Successor(%d) = %d, %t, want %d, %t", q, got, ok, wantSucc, wantSuccOK)
+		}
+	}
+}
+
+func TestYFastTrieMatchesSortedSlice(t *testing.T) {
+	const universe = 1 << 12
+
+	r := rand.New(rand.NewSource(1))
+	trie := NewYFastTrie(universe)
+
+	var keys []int
+	for op := range 6000 {
+		key := r.Intn(universe)
+		i, found := slices.BinarySearch(keys, key)
+
+		// Lean towards inserts so buckets fill up and split, then switch
+		// to deletes so they empty out again.
+		if (op < 4000) == (r.Intn(4) != 0) {
+			trie.Insert(key)
+			if !found {
+				keys = slices.Insert(keys, i, key)
+			}
+		} else {
+			if got := trie.Delete(key); got != found {
+				t.Fatalf("Delete(%d) = %t, want %t", key, got, found)
+			}
+			if found {
+				keys = slices.Delete(keys, i, i+1)
+			}
+		}
+
+		if op%250 == 0 {
+			checkYFastTrie(t, trie, keys, universe)
+		}
+	}
+
+	checkYFastTrie(t, trie, keys, universe)
+}
+
+func TestYFastTrieSequential(t *testing.T) {
+	const n = 500
+
+	trie := NewYFastTrie(1 << 16)
+
+	var keys []int
+	for i := range n {
+		trie.Insert(i * 3)
+		keys = append(keys, i*3)
+	}
+
+	checkYFastTrie(t, trie, keys, 3*n)
+
+	// Delete from the front so the first bucket repeatedly empties.
+	for len(keys) > 0 {
+		if !trie.Delete(keys[0]) {
+			t.Fatalf("Delete(%d) = false, want true", keys[0])
+		}
+		keys = keys[1:]
+
+		if len(keys)%50 == 0 {
+			checkYFastTrie(t, trie, keys, 3*n)
+		}
+	}
+
+	if trie.minLeaf != nil || trie.maxLeaf != nil {
+		t.Error("Expected no buckets after deleting every key")
+	}
+}
+
+func TestYFastTrieInsertOutOfRange(t *testing.T) {
+	// 1024 and 2047 fit in the trie's 11 key bits but are outside the
+	// universe of 0 through 1023.
+	for _, key := range []int{-1, 1024, 2047} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Insert(%d) did not panic", key)
+				}
+			}()
+
+			NewYFastTrie(1024).Insert(key)
+		}()
+	}
+}
+
+func TestYFastTrieUniverseBounds(t *testing.T) {
+	trie := NewYFastTrie(1000)
+	trie.Insert(0)
+	trie.Insert(999)
+
+	if trie.Delete(1000) {
+		t.Error("Delete(1000) = true, want false for a key outside the universe")
+	}
+
+	if succ, found := trie.Successor(999); !found || succ != 999 {
+		t.Errorf("Successor(999) = %d, %t, want 999, true", succ, found)
+	}
+
+	if succ, found := trie.Successor(1000); found {
+		t.Errorf("Successor(1000) = %d, want none outside the universe", succ)
+	}
+
+	if pred, found := trie.Predecessor(0); !found || pred != 0 {
+		t.Errorf("Predecessor(0) = %d, %t, want 0, true", pred, found)
+	}
+
+	if pred, found := trie.Predecessor(-1); found {
+		t.Errorf("Predecessor(-1) = %d, want none", pred)
+	}
+}
+
 // Benchmark functions for performance testing
 
 func BenchmarkYFastTrieInsert(b *testing.B) {
@@ -139,7 +290,7 @@ func BenchmarkYFastTrieInsert(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		trie.Insert(i)
+		trie.Insert(i % (1 << 20))
 	}
 }
 
@@ -0,0 +1,120 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlogstar
+
+// UnionFind is a disjoint-set forest over the elements 0 through n-1 using
+// link-by-rank and path compression.
+//
+// Link-by-rank keeps every tree O(log n) tall, and path compression points
+// every node visited by Find directly at its root. Hopcroft and Ullman
+// showed that together they make any sequence of m operations
+// O(m log* n), since a node's rank can only fall in one of log* n ranges
+// and each range pays for its own path compressions. Tarjan later tightened
+// this to O(m α(n)); see the inverseackermann package for that analysis.
+type UnionFind struct {
+	parent []int
+	rank   []int
+	count  int
+}
+
+// NewUnionFind creates a UnionFind with n elements, each in its own set.
+func NewUnionFind(n int) *UnionFind {
+	uf := &UnionFind{
+		parent: make([]int, n),
+		rank:   make([]int, n),
+		count:  n,
+	}
+
+	for i := range uf.parent {
+		uf.parent[i] = i
+	}
+
+	return uf
+}
+
+// Find returns the representative of the set containing x, pointing every
+// node on the way directly at it - O(log* n) amortized.
+func (uf *UnionFind) Find(x int) int {
+	root := x
+	for uf.parent[root] != root {
+		root = uf.parent[root]
+	}
+
+	for uf.parent[x] != root {
+		uf.parent[x], x = root, uf.parent[x]
+	}
+
+	return root
+}
+
+// Union merges the sets containing x and y, attaching the root of lower
+// rank beneath the other - O(log* n) amortized. It reports whether the two
+// were in different sets.
+func (uf *UnionFind) Union(x, y int) bool {
+	rootX, rootY := uf.Find(x), uf.Find(y)
+	if rootX == rootY {
+		return false
+	}
+
+	switch {
+	case uf.rank[rootX] < uf.rank[rootY]:
+		uf.parent[rootX] = rootY
+	case uf.rank[rootX] > uf.rank[rootY]:
+		uf.parent[rootY] = rootX
+	default:
+		uf.parent[rootY] = rootX
+		uf.rank[rootX]++
+	}
+
+	uf.count--
+
+	return true
+}
+
+// Connected reports whether x and y are in the same set - O(log* n)
+// amortized.
+func (uf *UnionFind) Connected(x, y int) bool {
+	return uf.Find(x) == uf.Find(y)
+}
+
+// Count returns the number of disjoint sets.
+func (uf *UnionFind) Count() int {
+	return uf.count
+}
+
+// PerformUnionFindOperations performs n unions followed by n finds on n
+// elements and returns the number of sets left - O(n log* n).
+//
+// The union pairs are spread across the whole range, so early unions join
+// many small trees and later ones merge large trees, building the deep
+// paths that path compression then has to flatten.
+func PerformUnionFindOperations(n int) int {
+	if n <= 0 {
+		return 0
+	}
+
+	uf := NewUnionFind(n)
+
+	for i := range n {
+		// 7919 is prime, so this visits partners all across the range.
+		uf.Union(i, (i*7919+n/2)%n)
+	}
+
+	for i := range n {
+		uf.Find(i)
+	}
+
+	return uf.Count()
+}
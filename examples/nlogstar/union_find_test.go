@@ -0,0 +1,137 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nlogstar
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestUnionFind(t *testing.T) {
+	uf := NewUnionFind(10)
+
+	if got := uf.Count(); got != 10 {
+		t.Fatalf("Count() = %d, want 10", got)
+	}
+
+	unions := []struct {
+		x, y int
+		want bool
+	}{
+		{0, 1, true},
+		{2, 3, true},
+		{1, 3, true},
+		{0, 2, false},
+		{4, 5, true},
+		{5, 4, false},
+		{9, 9, false},
+	}
+
+	for _, u := range unions {
+		if got := uf.Union(u.x, u.y); got != u.want {
+			t.Errorf("Union(%d, %d) = %t, want %t", u.x, u.y, got, u.want)
+		}
+	}
+
+	if got := uf.Count(); got != 6 {
+		t.Errorf("Count() = %d, want 6", got)
+	}
+
+	tests := []struct {
+		x, y int
+		want bool
+	}{
+		{0, 3, true},
+		{3, 2, true},
+		{4, 5, true},
+		{0, 4, false},
+		{6, 7, false},
+		{8, 8, true},
+	}
+
+	for _, tt := range tests {
+		if got := uf.Connected(tt.x, tt.y); got != tt.want {
+			t.Errorf("Connected(%d, %d) = %t, want %t", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestUnionFindPathCompression(t *testing.T) {
+	const n = 1000
+
+	uf := NewUnionFind(n)
+	for i := 1; i < n; i++ {
+		uf.Union(0, i)
+	}
+
+	if got := uf.Count(); got != 1 {
+		t.Fatalf("Count() = %d, want 1", got)
+	}
+
+	root := uf.Find(n - 1)
+	for i := range n {
+		uf.Find(i)
+		if uf.parent[i] != root {
+			t.Fatalf("after Find(%d), parent = %d, want root %d", i, uf.parent[i], root)
+		}
+	}
+
+	// Link-by-rank never lets a tree of n nodes reach rank above log₂(n).
+	if got := uf.rank[root]; got > 10 {
+		t.Errorf("root rank = %d, want at most 10", got)
+	}
+}
+
+// naiveComponents counts the connected components of the graph that
+// PerformUnionFindOperations builds by relabelling until nothing changes.
+func naiveComponents(n int) int {
+	label := make([]int, n)
+	for i := range label {
+		label[i] = i
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for i := range n {
+			j := (i*7919 + n/2) % n
+			if label[i] != label[j] {
+				low := min(label[i], label[j])
+				label[i], label[j] = low, low
+				changed = true
+			}
+		}
+	}
+
+	seen := map[int]bool{}
+	for _, l := range label {
+		seen[l] = true
+	}
+
+	return len(seen)
+}
+
+func TestPerformUnionFindOperations(t *testing.T) {
+	if got := PerformUnionFindOperations(0); got != 0 {
+		t.Errorf("PerformUnionFindOperations(0) = %d, want 0", got)
+	}
+
+	for _, n := range []int{1, 2, 10, 100, 7919, 10000} {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			if got, want := PerformUnionFindOperations(n), naiveComponents(n); got != want {
+				t.Errorf("PerformUnionFindOperations(%d) = %d, want %d", n, got, want)
+			}
+		})
+	}
+}
@@ -26,9 +26,10 @@
 //   - n=65536: (log₂(65536))² = 256 operations
 //   - n=1048576: (log₂(1048576))² = 400 operations
 //
-// Common use cases include range trees, segment trees with additional dimensions,
-// fractional cascading, and some parallel algorithms that require multiple
-// logarithmic factors for coordination.
+// Common use cases include range trees (see RangeTree2D), segment trees with
+// additional dimensions, fractional cascading (see FractionalCascade), and
+// some parallel algorithms that require multiple logarithmic factors for
+// coordination.
 //
 // Benchmarks can handle large input sizes (n ≤ 10^6) efficiently due to
 // the slow growth of logarithmic powers.
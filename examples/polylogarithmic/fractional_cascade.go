@@ -0,0 +1,124 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package polylogarithmic
+
+import "sort"
+
+// FractionalCascade answers the same successor query across k sorted lists
+// in O(log n + k) time, rather than the O(k log n) of a binary search in each
+// list, where n is the total number of elements.
+//
+// Each list is augmented with every other element of the augmented list
+// below it, and every augmented entry keeps a bridge to its position in the
+// next level. After one binary search in the first level, the position in
+// each following level is found by following the bridge and stepping back a
+// constant number of entries. The promoted elements halve at each level, so
+// the augmented lists stay O(n) in total size.
+type FractionalCascade struct {
+	levels []cascadeLevel
+}
+
+// cascadeLevel is one augmented list of a FractionalCascade. The slices
+// are parallel: entry i has value keys[i], the first index in the original
+// list whose value is >= keys[i] is orig[i], and the first index in the next
+// level's keys whose value is >= keys[i] is bridge[i]. orig and bridge each
+// have one extra entry for positions past the end of keys.
+type cascadeLevel struct {
+	keys   []int
+	orig   []int
+	bridge []int
+}
+
+// BuildFractionalCascade builds a FractionalCascade over lists, each of which
+// must be sorted in ascending order - O(n). The lists are not modified.
+func BuildFractionalCascade(lists [][]int) *FractionalCascade {
+	fc := &FractionalCascade{levels: make([]cascadeLevel, len(lists))}
+
+	// Build from the last list up, since each level promotes from the next.
+	var next []int
+	for i := len(lists) - 1; i >= 0; i-- {
+		var promoted []int
+		for j := 1; j < len(next); j += 2 {
+			promoted = append(promoted, next[j])
+		}
+
+		keys := mergeSorted(lists[i], promoted)
+		fc.levels[i] = cascadeLevel{
+			keys:   keys,
+			orig:   append(successorIndexes(keys, lists[i]), len(lists[i])),
+			bridge: append(successorIndexes(keys, next), len(next)),
+		}
+		next = keys
+	}
+
+	return fc
+}
+
+// successorIndexes returns, for each value in keys, the index of the first
+// value in list that is >= it. Both slices are sorted, so a single forward
+// pass over list suffices.
+func successorIndexes(keys, list []int) []int {
+	result := make([]int, len(keys))
+
+	j := 0
+	for i, key := range keys {
+		for j < len(list) && list[j] < key {
+			j++
+		}
+
+		result[i] = j
+	}
+
+	return result
+}
+
+// Search returns, for each list, the index of the first value that is >=
+// target, or the length of that list if every value is smaller -
+// O(log n + k).
+func (fc *FractionalCascade) Search(target int) []int {
+	result := make([]int, len(fc.levels))
+	if len(fc.levels) == 0 {
+		return result
+	}
+
+	pos := sort.SearchInts(fc.levels[0].keys, target)
+	for i, level := range fc.levels {
+		result[i] = level.orig[pos]
+
+		if i+1 == len(fc.levels) {
+			break
+		}
+
+		// The bridge points at the successor of keys[pos], which is at or
+		// just after the successor of target, since target falls between
+		// keys[pos-1] and keys[pos] and only every other entry between
+		// them was left out of this level.
+		next := fc.levels[i+1].keys
+		pos = level.bridge[pos]
+		for pos > 0 && next[pos-1] >= target {
+			pos--
+		}
+	}
+
+	return result
+}
+
+// FractionalCascadingSearch returns, for each of the sorted lists, the index
+// of the first value that is >= target. It builds a FractionalCascade for a
+// single search, which costs O(n); to search the same lists repeatedly, call
+// BuildFractionalCascade once and use its Search method.
+func FractionalCascadingSearch(lists [][]int, target int) []int {
+	return BuildFractionalCascade(lists).Search(target)
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package polylogarithmic
+
+import (
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+)
+
+// independentSearch binary searches each list separately in O(k log n).
+func independentSearch(lists [][]int, target int) []int {
+	result := make([]int, len(lists))
+	for i, list := range lists {
+		result[i] = sort.SearchInts(list, target)
+	}
+
+	return result
+}
+
+func TestFractionalCascadeSearch(t *testing.T) {
+	lists := [][]int{
+		{1, 5, 9, 13},
+		{2, 3, 5, 8, 21},
+		{},
+		{4, 4, 4, 10},
+		{0, 6, 7, 11, 12, 14},
+	}
+	fc := BuildFractionalCascade(lists)
+
+	tests := []struct {
+		target int
+		want   []int
+	}{
+		{-1, []int{0, 0, 0, 0, 0}},
+		{0, []int{0, 0, 0, 0, 0}},
+		{4, []int{1, 2, 0, 0, 1}},
+		{5, []int{1, 2, 0, 3, 1}},
+		{10, []int{3, 4, 0, 3, 3}},
+		{14, []int{4, 4, 0, 4, 5}},
+		{100, []int{4, 5, 0, 4, 6}},
+	}
+
+	for _, tt := range tests {
+		if got := fc.Search(tt.target); !slices.Equal(got, tt.want) {
+			t.Errorf("Search(%d) = %v, want %v", tt.target, got, tt.want)
+		}
+
+		if got := FractionalCascadingSearch(lists, tt.target); !slices.Equal(got, tt.want) {
+			t.Errorf("FractionalCascadingSearch(%d) = %v, want %v", tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestFractionalCascadeMatchesIndependentSearch(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, k := range []int{1, 2, 5, 16} {
+		lists := make([][]int, k)
+		for i := range lists {
+			lists[i] = make([]int, r.Intn(100))
+			for j := range lists[i] {
+				lists[i][j] = r.Intn(200)
+			}
+			slices.Sort(lists[i])
+		}
+
+		fc := BuildFractionalCascade(lists)
+
+		for target := -5; target <= 205; target++ {
+			want := independentSearch(lists, target)
+			if got := fc.Search(target); !slices.Equal(got, want) {
+				t.Fatalf("k=%d: Search(%d) = %v, want %v", k, target, got, want)
+			}
+		}
+	}
+}
+
+func TestFractionalCascadeSize(t *testing.T) {
+	// Promoting every other element means each level adds less than half
+	// of the level below, so the total stays under twice the input.
+	lists := make([][]int, 10)
+	total := 0
+	for i := range lists {
+		lists[i] = make([]int, 100)
+		for j := range lists[i] {
+			lists[i][j] = j*10 + i
+		}
+		total += len(lists[i])
+	}
+
+	augmented := 0
+	for _, level := range BuildFractionalCascade(lists).levels {
+		augmented += len(level.keys)
+	}
+
+	if augmented >= 2*total {
+		t.Errorf("augmented lists hold %d values, want fewer than %d", augmented, 2*total)
+	}
+}
+
+func TestFractionalCascadeEmpty(t *testing.T) {
+	if got := BuildFractionalCascade(nil).Search(3); len(got) != 0 {
+		t.Errorf("Search() with no lists = %v, want empty", got)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package polylogarithmic
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+)
+
+// Point2D is a point in the plane with integer coordinates.
+type Point2D struct {
+	X, Y int
+}
+
+// RangeTree2D is a static two-dimensional range tree that counts the points
+// inside an axis-aligned rectangle.
+//
+// The primary tree is a balanced binary tree over the points sorted by X.
+// Every node also keeps the sorted Y values of all the points beneath it. A
+// query splits the X range into O(log n) canonical nodes and binary searches
+// each node's Y values, giving O((log n)²) per query. The price is
+// O(n log n) space and build time, since every point appears once on each of
+// the log n levels.
+type RangeTree2D struct {
+	root *rangeTreeNode
+}
+
+// rangeTreeNode covers a contiguous run of the X-sorted points.
+type rangeTreeNode struct {
+	minX, maxX  int   // Smallest and largest X beneath this node.
+	ys          []int // Sorted Y values of every point beneath this node.
+	left, right *rangeTreeNode
+}
+
+// BuildRangeTree2D builds a RangeTree2D over points - O(n log n).
+// The input slice is not modified.
+func BuildRangeTree2D(points []Point2D) *RangeTree2D {
+	if len(points) == 0 {
+		return &RangeTree2D{root: nil}
+	}
+
+	sorted := slices.Clone(points)
+	slices.SortFunc(sorted, func(a, b Point2D) int {
+		return cmp.Compare(a.X, b.X)
+	})
+
+	return &RangeTree2D{root: buildRangeTreeNode(sorted)}
+}
+
+// buildRangeTreeNode builds the subtree over points, which are sorted by X.
+// Each node's Y values are merged from its children's, so every level of the
+// tree costs O(n).
+func buildRangeTreeNode(points []Point2D) *rangeTreeNode {
+	node := &rangeTreeNode{
+		minX:  points[0].X,
+		maxX:  points[len(points)-1].X,
+		ys:    nil,
+		left:  nil,
+		right: nil,
+	}
+
+	if len(points) == 1 {
+		node.ys = []int{points[0].Y}
+
+		return node
+	}
+
+	mid := len(points) / 2
+	node.left = buildRangeTreeNode(points[:mid])
+	node.right = buildRangeTreeNode(points[mid:])
+	node.ys = mergeSorted(node.left.ys, node.right.ys)
+
+	return node
+}
+
+// mergeSorted returns a new sorted slice holding the values of a and b.
+func mergeSorted(a, b []int) []int {
+	result := make([]int, 0, len(a)+len(b))
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if a[i] <= b[j] {
+			result = append(result, a[i])
+			i++
+		} else {
+			result = append(result, b[j])
+			j++
+		}
+	}
+
+	result = append(result, a[i:]...)
+
+	return append(result, b[j:]...)
+}
+
+// Query2D returns the number of points with x1 <= X <= x2 and
+// y1 <= Y <= y2 - O((log n)²). An empty rectangle returns 0.
+func (rt *RangeTree2D) Query2D(x1, x2, y1, y2 int) int {
+	if x1 > x2 || y1 > y2 {
+		return 0
+	}
+
+	return rt.root.query(x1, x2, y1, y2)
+}
+
+// query counts the points beneath node inside the rectangle.
+func (node *rangeTreeNode) query(x1, x2, y1, y2 int) int {
+	if node == nil || node.maxX < x1 || x2 < node.minX {
+		return 0
+	}
+
+	// Every point beneath this node is in the X range, so only Y matters.
+	if x1 <= node.minX && node.maxX <= x2 {
+		return sort.SearchInts(node.ys, y2+1) - sort.SearchInts(node.ys, y1)
+	}
+
+	return node.left.query(x1, x2, y1, y2) + node.right.query(x1, x2, y1, y2)
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package polylogarithmic
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// bruteForceCount counts the points inside the rectangle in O(n).
+func bruteForceCount(points []Point2D, x1, x2, y1, y2 int) int {
+	count := 0
+	for _, p := range points {
+		if x1 <= p.X && p.X <= x2 && y1 <= p.Y && p.Y <= y2 {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestRangeTree2DQuery(t *testing.T) {
+	points := []Point2D{
+		{X: 1, Y: 1}, {X: 2, Y: 5}, {X: 3, Y: 3}, {X: 3, Y: 7},
+		{X: 5, Y: 2}, {X: 6, Y: 6}, {X: 8, Y: 4}, {X: 9, Y: 9},
+	}
+	rt := BuildRangeTree2D(points)
+
+	tests := []struct {
+		name           string
+		x1, x2, y1, y2 int
+		want           int
+	}{
+		{"everything", 0, 10, 0, 10, 8},
+		{"nothing", 10, 20, 0, 10, 0},
+		{"single point", 5, 5, 2, 2, 1},
+		{"shared x", 3, 3, 0, 10, 2},
+		{"shared x, narrowed y", 3, 3, 4, 10, 1},
+		{"inner box", 2, 6, 2, 6, 4},
+		{"edges inclusive", 1, 9, 1, 9, 8},
+		{"inverted x", 6, 2, 0, 10, 0},
+		{"inverted y", 0, 10, 6, 2, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rt.Query2D(tt.x1, tt.x2, tt.y1, tt.y2); got != tt.want {
+				t.Errorf("Query2D(%d, %d, %d, %d) = %d, want %d", tt.x1, tt.x2, tt.y1, tt.y2, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeTree2DMatchesBruteForce(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, n := range []int{1, 2, 7, 64, 500} {
+		// A small coordinate range forces plenty of duplicate X and Y values.
+		points := make([]Point2D, n)
+		for i := range points {
+			points[i] = Point2D{X: r.Intn(50), Y: r.Intn(50)}
+		}
+
+		rt := BuildRangeTree2D(points)
+
+		for range 500 {
+			x1, x2 := r.Intn(60)-5, r.Intn(60)-5
+			y1, y2 := r.Intn(60)-5, r.Intn(60)-5
+
+			want := bruteForceCount(points, x1, x2, y1, y2)
+			if got := rt.Query2D(x1, x2, y1, y2); got != want {
+				t.Fatalf("n=%d: Query2D(%d, %d, %d, %d) = %d, want %d", n, x1, x2, y1, y2, got, want)
+			}
+		}
+	}
+}
+
+func TestRangeTree2DEmpty(t *testing.T) {
+	rt := BuildRangeTree2D(nil)
+
+	if got := rt.Query2D(-100, 100, -100, 100); got != 0 {
+		t.Errorf("Query2D() on an empty tree = %d, want 0", got)
+	}
+}
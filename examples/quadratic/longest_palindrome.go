@@ -0,0 +1,55 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quadratic
+
+// LongestPalindromeExpand returns the longest palindromic substring of s by
+// expanding around every possible center, in O(n²) time. Characters are
+// compared as runes. If several palindromes share the longest length, the
+// leftmost is returned. The empty string returns "".
+//
+// There are 2n-1 centers, one on each character for odd lengths and one
+// between each pair for even lengths, and each expansion can take up to n/2
+// steps. On typical text palindromes are short and this runs close to O(n),
+// but on a string such as "aaaa...a" every center expands to an edge,
+// giving the full O(n²). linear.LongestPalindrome uses Manacher's algorithm
+// to avoid repeating that work and stays O(n) on every input.
+func LongestPalindromeExpand(s string) string {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return ""
+	}
+
+	bestStart, bestLen := 0, 1
+
+	// expand grows the palindrome runes[low:high+1] while both ends match
+	// and records it if it is the longest so far
+	expand := func(low, high int) {
+		for low >= 0 && high < len(runes) && runes[low] == runes[high] {
+			low--
+			high++
+		}
+
+		if length := high - low - 1; length > bestLen {
+			bestStart, bestLen = low+1, length
+		}
+	}
+
+	for i := range runes {
+		expand(i, i)   // odd length, centered on runes[i]
+		expand(i, i+1) // even length, centered between runes[i] and runes[i+1]
+	}
+
+	return string(runes[bestStart : bestStart+bestLen])
+}
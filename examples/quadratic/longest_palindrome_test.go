@@ -0,0 +1,64 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quadratic
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestLongestPalindromeExpand(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{"empty string", "", ""},
+		{"single character", "x", "x"},
+		{"odd length", "xabcbay", "abcba"},
+		{"even length", "xabbay", "abba"},
+		{"whole string odd", "racecar", "racecar"},
+		{"whole string even", "abccba", "abccba"},
+		{"no palindrome longer than one", "abcdef", "a"},
+		{"two characters equal", "aa", "aa"},
+		{"two characters different", "ab", "a"},
+		{"all the same", "aaaaa", "aaaaa"},
+		{"leftmost of equal length", "abaxcdc", "aba"},
+		{"palindrome at the end", "abcdedc", "cdedc"},
+		{"multi-byte runes", "xéàéy", "éàé"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LongestPalindromeExpand(tt.s); got != tt.want {
+				t.Errorf("LongestPalindromeExpand(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkLongestPalindromeExpand(b *testing.B) {
+	for _, n := range []int{100, 1000, 10000} {
+		// Every center expands to an edge of the string, the O(n²) case
+		s := strings.Repeat("a", n)
+
+		b.Run(fmt.Sprintf("size_%d", n), func(b *testing.B) {
+			for b.Loop() {
+				LongestPalindromeExpand(s)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quadratic
+
+import "github.com/rsned/bigo/examples/cubic"
+
+// NaiveMatrixMultiplication returns the product of the m×k matrix a and the
+// k×p matrix b using the textbook definition. It is O(n³), not O(n²).
+//
+// Deprecated: Use cubic.NaiveMatrixMultiplication instead.
+func NaiveMatrixMultiplication(a, b [][]int) ([][]int, error) {
+	return cubic.NaiveMatrixMultiplication(a, b)
+}
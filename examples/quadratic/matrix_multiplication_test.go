@@ -0,0 +1,50 @@
+// Copyright 2025 Robert Snedegar
+//
+// Licensed under the Apache License, Version 2.0 (the License);
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an AS IS BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quadratic
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rsned/bigo/examples/cubic"
+)
+
+func TestNaiveMatrixMultiplicationForwardsToCubic(t *testing.T) {
+	tests := []struct {
+		name string
+		a    [][]int
+		b    [][]int
+	}{
+		{"2x3 times 3x2", [][]int{{1, 2, 3}, {4, 5, 6}}, [][]int{{7, 8}, {9, 10}, {11, 12}}},
+		{"empty", [][]int{}, [][]int{}},
+		{"inner dimension mismatch", [][]int{{1, 2}}, [][]int{{1, 2}}},
+		{"ragged first matrix", [][]int{{1, 2}, {3}}, [][]int{{1}, {2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, wantErr := cubic.NaiveMatrixMultiplication(tt.a, tt.b)
+			got, err := NaiveMatrixMultiplication(tt.a, tt.b)
+
+			if (err != nil) != (wantErr != nil) {
+				t.Fatalf("NaiveMatrixMultiplication(%v, %v) error = %v, want %v", tt.a, tt.b, err, wantErr)
+			}
+
+			if !cmp.Equal(got, want) {
+				t.Errorf("NaiveMatrixMultiplication(%v, %v) = %v, want %v", tt.a, tt.b, got, want)
+			}
+		})
+	}
+}
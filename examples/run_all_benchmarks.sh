@@ -99,8 +99,9 @@ for arg in "$@"; do
             echo "  Polylogarithmic_RangeTree2D_Build, Polylogarithmic_RangeTree2D_Query, Polylogarithmic_FractionalCascadingSearch"
             echo ""
             echo "Linear Time (O(n)):"
-            echo "  Linear_Search, Linear_ArrayTraversal, Linear_CountElements, Linear_FindMinimum,"
-            echo "  Linear_FindMaximum, Linear_CalculateSum, Linear_ParallelDivideConquer"
+            echo "  Linear_Search, Linear_IndexOf, Linear_ArrayTraversal, Linear_CountElements, Linear_FindMinimum,"
+            echo "  Linear_FindMaximum, Linear_CalculateSum, Linear_TreeHeight, Linear_BucketSort, Linear_CountingSort,"
+            echo "  Linear_RotateLeft, Linear_ParallelDivideConquer"
             echo ""
             echo "NLogStarN Time (O(n log*(n))):"
             echo "  NLogStarN_UnionFindOperations, NLogStarN_KruskalMST, NLogStarN_NetworkConnectivity"
@@ -113,11 +114,11 @@ for arg in "$@"; do
             echo "Quadratic Time (O(n²)):"
             echo "  Quadratic_BubbleSort, Quadratic_InsertionSort, Quadratic_SelectionSort,"
             echo "  Quadratic_AllPairsComparison, Quadratic_FindDuplicatePairs, Quadratic_CountInversions,"
-            echo "  Quadratic_TwoSum, Quadratic_NaiveMatrixMultiplication, Quadratic_MatrixTranspose"
+            echo "  Quadratic_TwoSum, Quadratic_MatrixTranspose"
             echo ""
             echo "Cubic Time (O(n³)):"
             echo "  Cubic_FloydWarshall, Cubic_ThreeSum, Cubic_MatrixChainMultiplication,"
-            echo "  Cubic_OptimalBinarySearchTree, Cubic_StandardMatrixMultiplication,"
+            echo "  Cubic_OptimalBinarySearchTree, Cubic_StandardMatrixMultiplication, Cubic_NaiveMatrixMultiplication,"
             echo "  Cubic_TripleNestedProductSum, Cubic_FindTripletsWithSum, Cubic_CountTripletsWithProperty,"
             echo "  Cubic_Generate3DCombinations"
             echo ""
@@ -194,7 +195,7 @@ filter_by_complexity() {
             echo "$params" | grep -E "^(Polylogarithmic_RangeTree2D_Build|Polylogarithmic_RangeTree2D_Query|Polylogarithmic_FractionalCascadingSearch):"
             ;;
         "Linear")
-            echo "$params" | grep -E "^(Linear_ArrayTraversal|Linear_CountElements|Linear_FindMinimum|Linear_FindMaximum|Linear_CalculateSum|Linear_FindTreeHeight|Linear_Search|Linear_ParallelDivideConquer):"
+            echo "$params" | grep -E "^(Linear_ArrayTraversal|Linear_CountElements|Linear_FindMinimum|Linear_FindMaximum|Linear_CalculateSum|Linear_TreeHeight|Linear_Search|Linear_IndexOf|Linear_BucketSort|Linear_CountingSort|Linear_RotateLeft|Linear_ParallelDivideConquer):"
             ;;
         "NLogStarN")
             echo "$params" | grep -E "^(NLogStarN_UnionFindOperations|NLogStarN_KruskalMST|NLogStarN_NetworkConnectivity):"
@@ -203,10 +204,10 @@ filter_by_complexity() {
             echo "$params" | grep -E "^(Linearithmic_MergeSort|Linearithmic_BuildHeapFromArray|Linearithmic_HeapifyArray|Linearithmic_IntroSort|Linearithmic_HeapSort|Linearithmic_QuickSort|Linearithmic_RadixSortOptimization):"
             ;;
         "Quadratic")
-            echo "$params" | grep -E "^(Quadratic_BubbleSort|Quadratic_InsertionSort|Quadratic_SelectionSort|Quadratic_AllPairsComparison|Quadratic_FindDuplicatePairs|Quadratic_CountInversions|Quadratic_TwoSum|Quadratic_NaiveMatrixMultiplication|Quadratic_MatrixTranspose):"
+            echo "$params" | grep -E "^(Quadratic_BubbleSort|Quadratic_InsertionSort|Quadratic_SelectionSort|Quadratic_AllPairsComparison|Quadratic_FindDuplicatePairs|Quadratic_CountInversions|Quadratic_TwoSum|Quadratic_MatrixTranspose):"
             ;;
         "Cubic")
-            echo "$params" | grep -E "^(Cubic_FloydWarshall|Cubic_ThreeSum|Cubic_MatrixChainMultiplication|Cubic_OptimalBinarySearchTree|Cubic_StandardMatrixMultiplication|Cubic_TripleNestedProductSum|Cubic_FindTripletsWithSum|Cubic_CountTripletsWithProperty|Cubic_Generate3DCombinations):"
+            echo "$params" | grep -E "^(Cubic_FloydWarshall|Cubic_ThreeSum|Cubic_MatrixChainMultiplication|Cubic_OptimalBinarySearchTree|Cubic_StandardMatrixMultiplication|Cubic_NaiveMatrixMultiplication|Cubic_TripleNestedProductSum|Cubic_FindTripletsWithSum|Cubic_CountTripletsWithProperty|Cubic_Generate3DCombinations):"
             ;;
         "Exponential")
             echo "$params" | grep -E "^(Exponential_RecursiveFibonacci|Exponential_TowerOfHanoi|Exponential_GenerateAllSubsets|Exponential_TravelingSalesmanBruteForce|Exponential_TSPBitMask):"
@@ -259,8 +260,12 @@ Linear_CalculateSum:10000:1000000:50000
 Linear_CountElements:10000:1000000:50000
 Linear_FindMinimum:10000:1000000:50000
 Linear_FindMaximum:10000:1000000:50000
-Linear_FindTreeHeight:1000:100000:5000
+Linear_TreeHeight:1000:100000:5000
 Linear_Search:10000:1000000:50000
+Linear_IndexOf:10000:1000000:50000
+Linear_BucketSort:10000:1000000:50000
+Linear_CountingSort:10000:1000000:50000
+Linear_RotateLeft:10000:1000000:50000
 Linear_ParallelDivideConquer:10000:100000:10000
 NLogStarN_UnionFindOperations:100:1000000:50000
 NLogStarN_KruskalMST:100:100000:10000
@@ -279,7 +284,6 @@ Quadratic_AllPairsComparison:500:5000:500
 Quadratic_FindDuplicatePairs:500:10000:500
 Quadratic_CountInversions:1000:10000:1000
 Quadratic_TwoSum:1000:10000:1000
-Quadratic_NaiveMatrixMultiplication:3:20:1
 Quadratic_MatrixTranspose:500:5000:500
 Cubic_CountTripletsWithProperty:250:2500:250
 Cubic_FindTripletsWithSum:250:2500:250
@@ -288,6 +292,7 @@ Cubic_Generate3DCombinations:5:50:5
 Cubic_MatrixChainMultiplication:50:750:50
 Cubic_OptimalBinarySearchTree:50:500:50
 Cubic_StandardMatrixMultiplication:100:1000:100
+Cubic_NaiveMatrixMultiplication:100:1000:100
 Cubic_TripleNestedProductSum:250:2500:250
 Cubic_ThreeSum:100:1000:100
 Exponential_RecursiveFibonacci:20:30:1
@@ -51,6 +51,8 @@ if [ $# -eq 0 ]; then
     echo "  --benchmark_start=N           Starting value for benchmark iterations"
     echo "  --benchmark_end=N             Ending value for benchmark iterations" 
     echo "  --benchmark_step=N            Step size for benchmark iterations"
+    echo "  --benchmark_step_mode=MODE    linear (add step) or geometric (multiply by step)"
+    echo "  --benchmark_csv_out=FILE      Write n,ns/op rows to FILE as the benchmarks run"
     echo "  --benchmark_example_name=NAME Name of example method to benchmark"
     echo "  --count=N                     Number of times to run the benchmark"
     echo ""
@@ -20,6 +20,10 @@ import "fmt"
 type Rating struct {
 	bigO  *BigO
 	score float64
+
+	// nonMonotonic is set on the winning rating when the mean values did not
+	// increase with N, which makes the fit questionable.
+	nonMonotonic bool
 }
 
 func (r *Rating) String() string {
@@ -37,8 +41,32 @@ func (r *Rating) Score() float64 {
 	return r.score
 }
 
+// RSquared returns the coefficient of determination R² for this rating,
+// which is the square of the correlation score. It is the fraction of the
+// variation in the values explained by a linear fit to the BigO's model
+// function, which is often easier to interpret than a correlation.
+//
+// The Constant class is scored from the coefficient of variation rather than
+// a correlation, so its score is returned unchanged.
+func (r *Rating) RSquared() float64 {
+	if r.bigO == Constant {
+		return r.score
+	}
+
+	return r.score * r.score
+}
+
+// NonMonotonic reports whether the data this rating was chosen for failed
+// the Classifier.IsMonotonic check. The classification is still the best
+// fit, but timings that fall as N grows (e.g., from caching effects) don't
+// match any cost growth curve, so it should be treated with suspicion.
+func (r *Rating) NonMonotonic() bool {
+	return r.nonMonotonic
+}
+
 // defaultRating is used when nothing has been processed yet.
 var defaultRating = &Rating{
-	bigO:  defaultBigO,
-	score: 0,
+	bigO:         defaultBigO,
+	score:        0,
+	nonMonotonic: false,
 }